@@ -0,0 +1,110 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Summary fields emitted by Aggregate.
+var (
+	AggregateCountKey       = NewUint64Key("count")
+	AggregateSumKey         = NewFloat64Key("sum")
+	AggregateMinKey         = NewFloat64Key("min")
+	AggregateMaxKey         = NewFloat64Key("max")
+	AggregateWindowStartKey = NewTimeKey("window_start")
+)
+
+// aggregator accumulates values for the current tumbling window.
+type aggregator struct {
+	mu       sync.Mutex
+	start    time.Time
+	count    uint64
+	sum      float64
+	min, max float64
+}
+
+// add folds a value into the current window.
+func (a *aggregator) add(v float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.count == 0 || v < a.min {
+		a.min = v
+	}
+	if a.count == 0 || v > a.max {
+		a.max = v
+	}
+	a.count++
+	a.sum += v
+}
+
+// roll closes the current window, starting the next at now, and returns the
+// closed window's summary fields, or nil if it saw no values.
+func (a *aggregator) roll(now time.Time) []Field {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var fields []Field
+	if a.count > 0 {
+		fields = []Field{
+			AggregateCountKey.Field(a.count),
+			AggregateSumKey.Field(a.sum),
+			AggregateMinKey.Field(a.min),
+			AggregateMaxKey.Field(a.max),
+			AggregateWindowStartKey.Field(a.start),
+		}
+	}
+	a.start, a.count, a.sum, a.min, a.max = now, 0, 0, 0, 0
+	return fields
+}
+
+// Aggregate summarizes valueKey across events on signal over tumbling windows
+// of the given length. At each window boundary it emits one event on out
+// carrying the window's count, sum, min, and max (AggregateCountKey and
+// friends) and its start time (AggregateWindowStartKey). Events without
+// valueKey are ignored, and windows with no values emit nothing.
+//
+// The window ticker stops on Shutdown or when stop is called; the partial
+// window in progress at that point is discarded. A window that is not
+// positive is invalid: nothing is hooked and the returned stop does nothing.
+func Aggregate(c *Capitan, signal Signal, valueKey Float64Key, window time.Duration, out Signal) (stop func()) {
+	if window <= 0 {
+		return func() {}
+	}
+
+	agg := &aggregator{start: c.clock()}
+	listener := c.hookInternal(signal, func(_ context.Context, e *Event) {
+		if v, ok := valueKey.From(e); ok {
+			agg.add(v)
+		}
+	})
+
+	// Created before the goroutine starts, so a test clock sees it at once
+	ticker := c.timers.NewTicker(window)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C():
+				if fields := agg.roll(c.clock()); fields != nil {
+					c.Emit(context.Background(), out, fields...)
+				}
+			case <-done:
+				return
+			case <-c.shutdown:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			listener.Close()
+			close(done)
+			<-exited
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestAggregate verifies window summaries and that empty windows emit nothing.
+func TestAggregate(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	latency := NewSignal("test.aggregate.latency", "Test aggregate latency signal")
+	summary := NewSignal("test.aggregate.summary", "Test aggregate summary signal")
+	ms := NewFloat64Key("ms")
+
+	var mu sync.Mutex
+	var got []map[string]any
+	c.Hook(summary, func(_ context.Context, e *Event) {
+		mu.Lock()
+		got = append(got, e.FieldsMap())
+		mu.Unlock()
+	})
+
+	stop := Aggregate(c, latency, ms, 50*time.Millisecond, summary)
+	defer stop()
+
+	for _, v := range []float64{4, 1, 7} {
+		c.Emit(context.Background(), latency, ms.Field(v))
+	}
+	c.Emit(context.Background(), latency) // no value, ignored
+
+	// Wait through the first boundary and an empty window
+	time.Sleep(130 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 1 {
+		t.Fatalf("expected exactly one summary, got %d: %v", len(got), got)
+	}
+	s := got[0]
+	if s["count"] != uint64(3) || s["sum"] != 12.0 || s["min"] != 1.0 || s["max"] != 7.0 {
+		t.Errorf("unexpected summary: %v", s)
+	}
+	if _, ok := s["window_start"].(time.Time); !ok {
+		t.Errorf("expected window_start time, got %v", s["window_start"])
+	}
+}
+
+// TestAggregateStopsOnShutdown verifies the ticker goroutine exits with the instance.
+func TestAggregateStopsOnShutdown(t *testing.T) {
+	c := New()
+	sig := NewSignal("test.aggregate.shutdown", "Test aggregate shutdown signal")
+	out := NewSignal("test.aggregate.shutdown.out", "Test aggregate shutdown out signal")
+
+	stop := Aggregate(c, sig, NewFloat64Key("v"), time.Hour, out)
+	c.Shutdown()
+
+	finished := make(chan struct{})
+	go func() {
+		stop()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("expected stop to return after Shutdown")
+	}
+}
+
+// TestAggregateInvalidWindow verifies a non-positive window hooks nothing
+// instead of panicking.
+func TestAggregateInvalidWindow(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.aggregate.window", "Test aggregate window signal")
+	out := NewSignal("test.aggregate.window.out", "Test aggregate window out signal")
+
+	for _, window := range []time.Duration{0, -time.Second} {
+		stop := Aggregate(c, sig, NewFloat64Key("v"), window, out)
+		if c.HasListeners(sig) {
+			t.Errorf("window %v: expected no listener hooked", window)
+		}
+		stop()
+	}
+}
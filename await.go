@@ -0,0 +1,145 @@
+package capitan
+
+import (
+	"context"
+	"time"
+)
+
+// EventReader is the read access shared by *Event and EventSnapshot, so code
+// inspecting events can be written once and handed either a live event inside
+// a listener or a snapshot retained after it.
+type EventReader interface {
+	Signal() Signal
+	Severity() Severity
+	Timestamp() time.Time
+	Sequence() uint64
+	Get(key Key) Field
+	Fields() []Field
+}
+
+// EventSnapshot is a copy of an event's data that stays valid after the
+// event is returned to the pool.
+type EventSnapshot struct {
+	signal    Signal
+	severity  Severity
+	timestamp time.Time
+	sequence  uint64
+	cause     *eventCause // shared with the event; never modified
+	fields    map[string]Field
+}
+
+// Snapshot copies the event's identity and fields into an EventSnapshot, for
+// listeners that need to keep the data beyond the callback. The event itself
+// is returned to the pool afterward and must not be retained.
+func (e *Event) Snapshot() EventSnapshot {
+	fields := make(map[string]Field, len(e.fields))
+	for name, field := range e.fields {
+		fields[name] = field
+	}
+	return EventSnapshot{
+		signal:    e.signal,
+		severity:  e.severity,
+		timestamp: e.timestamp,
+		sequence:  e.sequence,
+		cause:     e.cause,
+		fields:    fields,
+	}
+}
+
+// Signal returns the snapshotted event's signal.
+func (s EventSnapshot) Signal() Signal {
+	return s.signal
+}
+
+// Severity returns the snapshotted event's severity.
+func (s EventSnapshot) Severity() Severity {
+	return s.severity
+}
+
+// Timestamp returns when the snapshotted event was created.
+func (s EventSnapshot) Timestamp() time.Time {
+	return s.timestamp
+}
+
+// Sequence returns the snapshotted event's emission sequence number.
+func (s EventSnapshot) Sequence() uint64 {
+	return s.sequence
+}
+
+// Cause returns the signal and timestamp of the event that caused the
+// snapshotted one. ok is false unless it was emitted with EmitCaused.
+func (s EventSnapshot) Cause() (signal Signal, timestamp time.Time, ok bool) {
+	if s.cause == nil {
+		return Signal{}, time.Time{}, false
+	}
+	return s.cause.signal, s.cause.timestamp, true
+}
+
+// CauseSequence returns the Sequence of the event that caused the
+// snapshotted one, or 0 if it was not emitted with EmitCaused.
+func (s EventSnapshot) CauseSequence() uint64 {
+	if s.cause == nil {
+		return 0
+	}
+	return s.cause.sequence
+}
+
+// Get retrieves a field by key, returning nil if not found or if key is nil.
+func (s EventSnapshot) Get(key Key) Field {
+	if key == nil {
+		return nil
+	}
+	return s.fields[key.Name()]
+}
+
+// Fields returns all fields as a slice.
+// Returns a defensive copy; modifications don't affect the snapshot.
+func (s EventSnapshot) Fields() []Field {
+	result := make([]Field, 0, len(s.fields))
+	for _, field := range s.fields {
+		result = append(result, field)
+	}
+	return result
+}
+
+// Await waits on the default instance for the next event on signal that
+// satisfies predicate.
+func Await(ctx context.Context, signal Signal, predicate func(*Event) bool) (EventSnapshot, error) {
+	return defaultInstance().Await(ctx, signal, predicate)
+}
+
+// Await hooks a temporary listener on signal and blocks until an event
+// satisfies predicate, returning a snapshot of it. A nil predicate matches any
+// event. Only events delivered after Await is called are considered.
+//
+// The listener is removed before Await returns, whether an event matched, ctx
+// was done (returning ctx.Err()), or the instance was shut down (returning
+// ErrShutdown). The predicate runs on the signal's worker and must not retain
+// the event.
+func (c *Capitan) Await(ctx context.Context, signal Signal, predicate func(*Event) bool) (EventSnapshot, error) {
+	if c.IsShutdown() {
+		return EventSnapshot{}, ErrShutdown
+	}
+
+	matched := make(chan EventSnapshot, 1)
+	listener := c.hookInternal(signal, func(_ context.Context, e *Event) {
+		if predicate != nil && !predicate(e) {
+			return
+		}
+		select {
+		case matched <- e.Snapshot():
+		default:
+			// An earlier event already matched
+		}
+	})
+	defer listener.Close()
+
+	select {
+	case snap := <-matched:
+		return snap, nil
+	case <-ctx.Done():
+		return EventSnapshot{}, ctx.Err()
+	case <-c.shutdown:
+		return EventSnapshot{}, ErrShutdown
+	}
+}
@@ -0,0 +1,183 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// emitWhenHooked emits fields on signal once a listener is registered.
+func emitWhenHooked(c *Capitan, signal Signal, batches ...[]Field) {
+	go func() {
+		for !c.HasListeners(signal) {
+			time.Sleep(time.Millisecond)
+		}
+		for _, fields := range batches {
+			c.Emit(context.Background(), signal, fields...)
+		}
+	}()
+}
+
+func TestAwait(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("await.match", "Test await signal")
+	orderID := NewStringKey("order_id")
+
+	emitWhenHooked(c, sig, []Field{orderID.Field("A1")})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	snap, err := c.Await(ctx, sig, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if snap.Signal() != sig || snap.Severity() != SeverityInfo {
+		t.Errorf("unexpected snapshot identity: %v %v", snap.Signal(), snap.Severity())
+	}
+	if snap.Timestamp().IsZero() || snap.Sequence() == 0 {
+		t.Error("expected snapshot timestamp and sequence")
+	}
+	if f, ok := snap.Get(orderID).(GenericField[string]); !ok || f.Get() != "A1" {
+		t.Errorf("expected order_id A1, got %v", snap.Get(orderID))
+	}
+	if got := len(snap.Fields()); got != 1 {
+		t.Errorf("expected 1 field, got %d", got)
+	}
+	if c.HasListeners(sig) {
+		t.Error("expected temporary listener to be removed after match")
+	}
+}
+
+func TestAwaitTimeout(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("await.timeout", "Test await timeout signal")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.Await(ctx, sig, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+	if c.HasListeners(sig) {
+		t.Error("expected temporary listener to be removed after timeout")
+	}
+}
+
+func TestAwaitPredicate(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("await.predicate", "Test await predicate signal")
+	amount := NewIntKey("amount")
+
+	emitWhenHooked(c, sig,
+		[]Field{amount.Field(5)},
+		[]Field{amount.Field(50)},
+		[]Field{amount.Field(500)},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	snap, err := c.Await(ctx, sig, func(e *Event) bool {
+		v, _ := amount.From(e)
+		return v > 10
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f, ok := snap.Get(amount).(GenericField[int]); !ok || f.Get() != 50 {
+		t.Errorf("expected first matching amount 50, got %v", snap.Get(amount))
+	}
+}
+
+func TestAwaitShutdown(t *testing.T) {
+	c := New()
+	sig := NewSignal("await.shutdown", "Test await shutdown signal")
+
+	go func() {
+		for !c.HasListeners(sig) {
+			time.Sleep(time.Millisecond)
+		}
+		c.Shutdown()
+	}()
+
+	_, err := c.Await(context.Background(), sig, nil)
+	if !errors.Is(err, ErrShutdown) {
+		t.Errorf("expected ErrShutdown, got %v", err)
+	}
+	if _, err := c.Await(context.Background(), sig, nil); !errors.Is(err, ErrShutdown) {
+		t.Errorf("expected ErrShutdown after shutdown, got %v", err)
+	}
+}
+
+// TestEventSnapshotSurvivesRecycling verifies a snapshot keeps its data after
+// the event is returned to the pool and repopulated.
+func TestEventSnapshotSurvivesRecycling(t *testing.T) {
+	orderID := NewStringKey("order_id")
+	first := NewSignal("test.snapshot.first", "Test snapshot first signal")
+	second := NewSignal("test.snapshot.second", "Test snapshot second signal")
+
+	// A pool that always hands back the same event forces reuse
+	recycled := &Event{fields: make(map[string]Field)}
+	pool := &sync.Pool{New: func() any { return recycled }}
+
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := newPooledEvent(context.Background(), pool, first, SeverityWarn, ts, orderID.Field("A1"))
+	snap := e.Snapshot()
+	pool.Put(e)
+
+	reused := newPooledEvent(context.Background(), pool, second, SeverityInfo, ts.Add(time.Hour), orderID.Field("B2"))
+	if reused != e {
+		t.Fatal("expected the pool to hand back the same event")
+	}
+
+	if snap.Signal() != first || snap.Severity() != SeverityWarn || !snap.Timestamp().Equal(ts) {
+		t.Errorf("expected snapshot identity unchanged, got %s %s %v", snap.Signal().Name(), snap.Severity(), snap.Timestamp())
+	}
+	if snap.Sequence() == reused.Sequence() {
+		t.Errorf("expected snapshot to keep its own sequence, got %d", snap.Sequence())
+	}
+	if f, ok := snap.Get(orderID).(GenericField[string]); !ok || f.Value() != "A1" {
+		t.Errorf("expected snapshot field A1, got %v", snap.Get(orderID))
+	}
+	if fields := snap.Fields(); len(fields) != 1 || fields[0].Value() != "A1" {
+		t.Errorf("expected snapshot fields [A1], got %v", fields)
+	}
+}
+
+func TestEventReader(t *testing.T) {
+	orderID := NewStringKey("order_id")
+	sig := NewSignal("test.snapshot.reader", "Test event reader signal")
+
+	// Written once against EventReader, used with live events and snapshots
+	describe := func(r EventReader) string {
+		f := r.Get(orderID)
+		if f == nil {
+			return r.Signal().Name()
+		}
+		return r.Signal().Name() + ":" + f.Value().(string)
+	}
+
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	var live string
+	var snap EventSnapshot
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		live = describe(e)
+		snap = e.Snapshot()
+	})
+	c.Emit(context.Background(), sig, orderID.Field("A1"))
+
+	want := "test.snapshot.reader:A1"
+	if live != want || describe(snap) != want {
+		t.Errorf("expected %q from both, got %q and %q", want, live, describe(snap))
+	}
+}
@@ -0,0 +1,128 @@
+package capitan
+
+import "sync"
+
+// listenerQueue is a buffered listener's private queue and delivery goroutine state.
+type listenerQueue struct {
+	events   chan *Event
+	done     chan struct{} // closed to drain and stop the delivery goroutine
+	stopOnce sync.Once
+}
+
+// stop signals the delivery goroutine to drain its queue and exit.
+func (q *listenerQueue) stop() {
+	q.stopOnce.Do(func() { close(q.done) })
+}
+
+// HookBuffered registers a callback with its own queue on the default instance.
+func HookBuffered(signal Signal, buffer int, callback EventCallback) *Listener {
+	return defaultInstance().HookBuffered(signal, buffer, callback)
+}
+
+// HookBuffered registers a callback that runs on its own goroutine with a
+// private queue of the given size, so a slow listener doesn't delay the
+// signal's other listeners. The signal's worker hands each event over and
+// moves on. When the queue is full the event is dropped for this listener
+// only and reported with DropReasonOverflow. Close drains the queue and then
+// stops the goroutine. In sync mode the callback is invoked directly.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookBuffered(signal Signal, buffer int, callback EventCallback) *Listener {
+	return c.hookBuffered(&Listener{
+		signal:   signal,
+		callback: callback,
+		capitan:  c,
+	}, buffer)
+}
+
+// hookBuffered gives listener a queue of the given size and registers it.
+func (c *Capitan) hookBuffered(listener *Listener, buffer int) *Listener {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.syncMode {
+		listener.queue = &listenerQueue{
+			events: make(chan *Event, max(buffer, 1)),
+			done:   make(chan struct{}),
+		}
+		c.listenerWG.Add(1)
+		go c.deliverBuffered(listener)
+	}
+	return c.register(listener)
+}
+
+// handOff passes a copy of the event to a buffered listener's queue without blocking.
+func (c *Capitan) handOff(listener *Listener, event *Event) {
+	q := listener.queue
+	select {
+	case <-q.done:
+		// Listener closed after the worker copied the listener list
+		return
+	default:
+	}
+
+	c.pushBuffered(q, event.clone(c.pool))
+}
+
+// pushBuffered queues an event the queue takes ownership of, dropping it
+// with DropReasonOverflow when the queue is full.
+func (c *Capitan) pushBuffered(q *listenerQueue, event *Event) {
+	event.enqueuedAt = c.clock()
+	c.bufferedPending.Add(1)
+	select {
+	case q.events <- event:
+	default:
+		c.bufferedPending.Add(-1)
+		c.dropEvent(event, DropReasonOverflow)
+	}
+}
+
+// deliverBuffered is the delivery goroutine for a buffered or fallback listener.
+func (c *Capitan) deliverBuffered(listener *Listener) {
+	defer c.listenerWG.Done()
+	q := listener.queue
+
+	deliver := func(event *Event) {
+		if event.ctx.Err() != nil && !c.processCanceled {
+			c.dropEvent(event, DropReasonCanceled)
+		} else {
+			event.startedAt = c.clock()
+			c.invokeListener(event.signal, listener, event)
+			c.pool.Put(event)
+		}
+		c.bufferedPending.Add(-1)
+	}
+
+	for {
+		select {
+		case event := <-q.events:
+			deliver(event)
+		case <-q.done:
+			for {
+				select {
+				case event := <-q.events:
+					deliver(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// stopBufferedListeners drains and stops all buffered listener goroutines.
+// Called by Shutdown once workers have finished handing off events.
+func (c *Capitan) stopBufferedListeners() {
+	c.mu.RLock()
+	for _, listeners := range c.registry {
+		for _, l := range listeners {
+			if l.queue != nil {
+				l.queue.stop()
+			}
+		}
+	}
+	c.mu.RUnlock()
+	if fallback := c.fallback.Load(); fallback != nil {
+		fallback.release()
+	}
+	c.listenerWG.Wait()
+}
@@ -0,0 +1,165 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHookBufferedDoesNotDelayOtherListeners(t *testing.T) {
+	c := New(WithBufferSize(128))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.buffered.isolation", "Test buffered isolation signal")
+
+	var fast, slow atomic.Int32
+	fastDone := make(chan time.Time, 1)
+	c.HookBuffered(sig, 128, func(_ context.Context, _ *Event) {
+		time.Sleep(time.Millisecond)
+		slow.Add(1)
+	})
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		if fast.Add(1) == 100 {
+			fastDone <- time.Now()
+		}
+	})
+
+	for i := 0; i < 100; i++ {
+		c.Emit(context.Background(), sig)
+	}
+
+	select {
+	case <-fastDone:
+	case <-time.After(time.Second):
+		t.Fatal("fast listener did not finish")
+	}
+	if n := slow.Load(); n >= 50 {
+		t.Errorf("expected slow listener well behind when fast finished, got %d/100", n)
+	}
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if n := slow.Load(); n != 100 {
+		t.Errorf("expected slow listener to receive all 100 events, got %d", n)
+	}
+}
+
+func TestHookBufferedOverflow(t *testing.T) {
+	var mu sync.Mutex
+	var drops []DropReason
+	c := New(WithDropHandler(func(_ Signal, reason DropReason) {
+		mu.Lock()
+		drops = append(drops, reason)
+		mu.Unlock()
+	}))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.buffered.overflow", "Test buffered overflow signal")
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var once sync.Once
+	var received atomic.Int32
+	c.HookBuffered(sig, 1, func(_ context.Context, _ *Event) {
+		once.Do(func() { close(started) })
+		<-release
+		received.Add(1)
+	})
+
+	c.Emit(context.Background(), sig) // held by the listener
+	<-started
+	c.Emit(context.Background(), sig) // queued
+	c.Emit(context.Background(), sig) // overflows
+
+	// Wait for the worker to hand off all three events
+	deadline := time.Now().Add(time.Second)
+	for c.inFlight.Load() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(drops) != 1 || drops[0] != DropReasonOverflow {
+		t.Errorf("expected one overflow drop, got %v", drops)
+	}
+	if received.Load() != 2 {
+		t.Errorf("expected 2 delivered events, got %d", received.Load())
+	}
+}
+
+func TestHookBufferedCloseDrains(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.buffered.close", "Test buffered close signal")
+	var received atomic.Int32
+	listener := c.HookBuffered(sig, 16, func(_ context.Context, _ *Event) {
+		time.Sleep(time.Millisecond)
+		received.Add(1)
+	})
+	// Keep the worker alive after the buffered listener closes
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+
+	for i := 0; i < 10; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	for c.inFlight.Load() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	listener.Close()
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if n := received.Load(); n != 10 {
+		t.Errorf("expected queued events drained after Close, got %d", n)
+	}
+
+	c.Emit(context.Background(), sig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if n := received.Load(); n != 10 {
+		t.Errorf("expected no delivery after Close, got %d", n)
+	}
+}
+
+func TestHookBufferedShutdownDrains(t *testing.T) {
+	c := New()
+
+	sig := NewSignal("test.buffered.shutdown", "Test buffered shutdown signal")
+	var received atomic.Int32
+	c.HookBuffered(sig, 16, func(_ context.Context, _ *Event) {
+		time.Sleep(time.Millisecond)
+		received.Add(1)
+	})
+
+	for i := 0; i < 10; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	c.Shutdown()
+
+	if n := received.Load(); n != 10 {
+		t.Errorf("expected Shutdown to drain buffered listener, got %d", n)
+	}
+}
+
+func TestHookBufferedSyncMode(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.buffered.sync", "Test buffered sync signal")
+	received := 0
+	c.HookBuffered(sig, 4, func(_ context.Context, _ *Event) { received++ })
+
+	c.Emit(context.Background(), sig)
+	if received != 1 {
+		t.Errorf("expected direct delivery in sync mode, got %d", received)
+	}
+}
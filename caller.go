@@ -0,0 +1,28 @@
+package capitan
+
+import (
+	"runtime"
+	"strings"
+)
+
+// callerFrame returns the file and line of the first stack frame outside capitan.
+// Test files within the package are treated as external callers.
+func callerFrame() (string, int) {
+	var pcs [16]uintptr
+	n := runtime.Callers(1, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+
+	// The first frame is callerFrame itself; derive the package prefix from it.
+	first, more := frames.Next()
+	prefix := first.Function[:strings.LastIndex(first.Function, ".")+1]
+
+	for more {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		if strings.HasPrefix(frame.Function, prefix) && !strings.HasSuffix(frame.File, "_test.go") {
+			continue
+		}
+		return frame.File, frame.Line
+	}
+	return "", 0
+}
@@ -0,0 +1,75 @@
+package capitan
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestWithCallerInfo(t *testing.T) {
+	c := New(WithCallerInfo(), WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.caller", "Test caller signal")
+
+	var file string
+	var line int
+	var ok bool
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		file, line, ok = e.Caller()
+	})
+
+	_, _, expectedLine, _ := runtime.Caller(0)
+	c.Emit(context.Background(), sig)
+
+	if !ok {
+		t.Fatal("expected caller info to be present")
+	}
+	if filepath.Base(file) != "caller_test.go" {
+		t.Errorf("expected caller file caller_test.go, got %s", file)
+	}
+	if line != expectedLine+1 {
+		t.Errorf("expected caller line %d, got %d", expectedLine+1, line)
+	}
+}
+
+func TestWithCallerInfoAsync(t *testing.T) {
+	c := New(WithCallerInfo())
+
+	sig := NewSignal("test.caller.async", "Test async caller signal")
+
+	var file string
+	var ok bool
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		file, _, ok = e.Caller()
+	})
+
+	c.Warn(context.Background(), sig)
+	c.Shutdown()
+
+	if !ok {
+		t.Fatal("expected caller info to be present")
+	}
+	if filepath.Base(file) != "caller_test.go" {
+		t.Errorf("expected caller file caller_test.go, got %s", file)
+	}
+}
+
+func TestCallerInfoDisabledByDefault(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.caller.disabled", "Test disabled caller signal")
+
+	ok := true
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		_, _, ok = e.Caller()
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if ok {
+		t.Error("expected no caller info without WithCallerInfo")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/zoobzio/capitan/capitanws
+
+go 1.23
+
+require (
+	github.com/coder/websocket v1.8.13
+	github.com/zoobzio/capitan v0.0.0
+)
+
+replace github.com/zoobzio/capitan => ../
@@ -0,0 +1,140 @@
+// Package capitanws streams capitan events to WebSocket clients.
+//
+// It lives in its own module so the core capitan package stays free of
+// third-party dependencies.
+package capitanws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/coder/websocket"
+	"github.com/zoobzio/capitan"
+)
+
+// sendBuffer is the number of encoded events queued per connection.
+const sendBuffer = 64
+
+// Subscribe is the message a client sends to choose which events it receives.
+// Each message replaces the previous subscription.
+type Subscribe struct {
+	// Signals lists signal names to stream. Empty means all signals.
+	Signals []string `json:"signals"`
+
+	// MinSeverity filters out events below this severity. Empty means all.
+	MinSeverity capitan.Severity `json:"min_severity"`
+}
+
+// Handler returns an http.Handler that upgrades requests to WebSocket
+// connections and streams events from c as JSON text frames, encoded with
+// capitan.JSONEncoder. Nothing is sent until the client sends a Subscribe
+// message; later Subscribe messages adjust the subscription in place.
+//
+// Each connection has its own observer, closed when the client disconnects.
+// Encoded events wait in a bounded per-connection queue, so a slow client
+// never blocks workers; events that don't fit are dropped for that client.
+func Handler(c *capitan.Capitan) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return // Accept has already written the error response
+		}
+		defer conn.CloseNow() //nolint:errcheck // Best-effort close after a normal close or read error
+
+		s := &session{c: c, send: make(chan []byte, sendBuffer)}
+		defer s.unsubscribe()
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go s.write(ctx, conn)
+
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+			var sub Subscribe
+			if err := json.Unmarshal(data, &sub); err != nil {
+				conn.Close(websocket.StatusUnsupportedData, "invalid subscribe message") //nolint:errcheck // Connection is abandoned either way
+				return
+			}
+			s.subscribe(sub)
+		}
+	})
+}
+
+// session is one client connection's subscription state.
+type session struct {
+	c    *capitan.Capitan
+	send chan []byte
+
+	mu       sync.Mutex
+	observer *capitan.Observer
+}
+
+// subscribe replaces the session's observer with one matching sub.
+func (s *session) subscribe(sub Subscribe) {
+	var names map[string]bool
+	if len(sub.Signals) > 0 {
+		names = make(map[string]bool, len(sub.Signals))
+		for _, name := range sub.Signals {
+			names[name] = true
+		}
+	}
+
+	callback := func(_ context.Context, e *capitan.Event) {
+		if names != nil && !names[e.Signal().Name()] {
+			return
+		}
+		payload, err := capitan.JSONEncoder{}.Encode(e)
+		if err != nil {
+			return
+		}
+		select {
+		case s.send <- payload:
+		default:
+			// Client is too slow; drop rather than block the worker
+		}
+	}
+
+	var observer *capitan.Observer
+	if sub.MinSeverity != "" {
+		observer = s.c.ObserveSeverity(sub.MinSeverity, callback)
+	} else {
+		observer = s.c.Observe(callback)
+	}
+
+	s.mu.Lock()
+	previous := s.observer
+	s.observer = observer
+	s.mu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+}
+
+// unsubscribe closes the session's observer, if any.
+func (s *session) unsubscribe() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.observer != nil {
+		s.observer.Close()
+		s.observer = nil
+	}
+}
+
+// write sends queued events to the client until ctx is done or a write fails.
+func (s *session) write(ctx context.Context, conn *websocket.Conn) {
+	for {
+		select {
+		case payload := <-s.send:
+			if err := conn.Write(ctx, websocket.MessageText, payload); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
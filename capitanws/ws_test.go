@@ -0,0 +1,105 @@
+package capitanws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/zoobzio/capitan"
+)
+
+func TestHandlerStreamsSubscribedEvents(t *testing.T) {
+	c := capitan.New()
+	defer c.Shutdown()
+
+	srv := httptest.NewServer(Handler(c))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.CloseNow() //nolint:errcheck // Test cleanup
+
+	sub, err := json.Marshal(Subscribe{Signals: []string{"order.failed"}, MinSeverity: capitan.SeverityWarn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.Write(ctx, websocket.MessageText, sub); err != nil {
+		t.Fatalf("write subscribe: %v", err)
+	}
+
+	// The subscription is applied asynchronously; wait for its observer.
+	for c.ObserverCount() == 0 {
+		if ctx.Err() != nil {
+			t.Fatal("subscription was never applied")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	failed := capitan.NewSignal("order.failed", "Order failed")
+	other := capitan.NewSignal("order.placed", "Order placed")
+	key := capitan.NewStringKey("order_id")
+
+	c.Warn(ctx, other, key.Field("skipped"))
+	c.Info(ctx, failed, key.Field("too-quiet"))
+	c.Error(ctx, failed, key.Field("A-1"))
+
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	var got struct {
+		Signal   string         `json:"signal"`
+		Severity string         `json:"severity"`
+		Fields   map[string]any `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	if got.Signal != "order.failed" || got.Severity != "ERROR" || got.Fields["order_id"] != "A-1" {
+		t.Errorf("got %s", data)
+	}
+}
+
+func TestHandlerClosesObserverOnDisconnect(t *testing.T) {
+	c := capitan.New()
+	defer c.Shutdown()
+
+	srv := httptest.NewServer(Handler(c))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	if err := conn.Write(ctx, websocket.MessageText, []byte(`{}`)); err != nil {
+		t.Fatalf("write subscribe: %v", err)
+	}
+	for c.ObserverCount() == 0 {
+		if ctx.Err() != nil {
+			t.Fatal("subscription was never applied")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	conn.Close(websocket.StatusNormalClosure, "") //nolint:errcheck // Test only cares about server cleanup
+
+	for c.ObserverCount() != 0 {
+		if ctx.Err() != nil {
+			t.Fatal("observer was not closed after disconnect")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
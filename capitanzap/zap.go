@@ -0,0 +1,72 @@
+// Package capitanzap converts capitan fields into zap fields.
+//
+// It lives in its own module so the core capitan package stays free of
+// third-party dependencies.
+package capitanzap
+
+import (
+	"time"
+
+	"github.com/zoobzio/capitan"
+	"go.uber.org/zap"
+)
+
+// FieldToZapField converts a capitan field into a zap.Field using the typed
+// constructor for its variant (zap.String, zap.Int64, zap.Duration, ...).
+// Custom variants, or fields whose concrete type doesn't match their
+// variant, fall back to zap.Any, and values implementing capitan.LogValuer
+// are logged as their LogValue. Returns zap.Skip() for a nil field.
+func FieldToZapField(f capitan.Field) zap.Field {
+	v := zapVisitor{}
+	capitan.VisitField(&v, f)
+	if len(v.fields) == 0 {
+		return zap.Skip()
+	}
+	return v.fields[0]
+}
+
+// EventFields converts all fields of an event into zap fields, following
+// the same rules as FieldToZapField.
+func EventFields(e *capitan.Event) []zap.Field {
+	v := zapVisitor{fields: make([]zap.Field, 0, len(e.Fields()))}
+	e.Walk(&v)
+	return v.fields
+}
+
+// zapVisitor is a capitan.FieldVisitor that collects zap fields.
+type zapVisitor struct {
+	fields []zap.Field
+}
+
+func (v *zapVisitor) add(f zap.Field) { v.fields = append(v.fields, f) }
+
+func (v *zapVisitor) String(name string, value string)   { v.add(zap.String(name, value)) }
+func (v *zapVisitor) Int(name string, value int)         { v.add(zap.Int(name, value)) }
+func (v *zapVisitor) Int32(name string, value int32)     { v.add(zap.Int32(name, value)) }
+func (v *zapVisitor) Int64(name string, value int64)     { v.add(zap.Int64(name, value)) }
+func (v *zapVisitor) Uint(name string, value uint)       { v.add(zap.Uint(name, value)) }
+func (v *zapVisitor) Uint32(name string, value uint32)   { v.add(zap.Uint32(name, value)) }
+func (v *zapVisitor) Uint64(name string, value uint64)   { v.add(zap.Uint64(name, value)) }
+func (v *zapVisitor) Float32(name string, value float32) { v.add(zap.Float32(name, value)) }
+func (v *zapVisitor) Float64(name string, value float64) { v.add(zap.Float64(name, value)) }
+func (v *zapVisitor) Bool(name string, value bool)       { v.add(zap.Bool(name, value)) }
+func (v *zapVisitor) Time(name string, value time.Time)  { v.add(zap.Time(name, value)) }
+func (v *zapVisitor) Duration(name string, value time.Duration) {
+	v.add(zap.Duration(name, value))
+}
+func (v *zapVisitor) Bytes(name string, value []byte) { v.add(zap.Binary(name, value)) }
+
+func (v *zapVisitor) Error(name string, value error) {
+	if lv, ok := value.(capitan.LogValuer); ok {
+		v.add(zap.Any(name, lv.LogValue()))
+		return
+	}
+	v.add(zap.NamedError(name, value))
+}
+
+func (v *zapVisitor) Default(name string, value any) {
+	if lv, ok := value.(capitan.LogValuer); ok {
+		value = lv.LogValue()
+	}
+	v.add(zap.Any(name, value))
+}
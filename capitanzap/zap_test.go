@@ -0,0 +1,102 @@
+package capitanzap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/capitan"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFieldToZapField(t *testing.T) {
+	now := time.Unix(100, 0)
+	testErr := errors.New("boom")
+
+	type custom struct{ ID string }
+
+	tests := []struct {
+		name  string
+		field capitan.Field
+		want  zap.Field
+	}{
+		{"string", capitan.NewStringKey("k").Field("v"), zap.String("k", "v")},
+		{"int", capitan.NewIntKey("k").Field(1), zap.Int("k", 1)},
+		{"int32", capitan.NewInt32Key("k").Field(2), zap.Int32("k", 2)},
+		{"int64", capitan.NewInt64Key("k").Field(3), zap.Int64("k", 3)},
+		{"uint", capitan.NewUintKey("k").Field(4), zap.Uint("k", 4)},
+		{"uint32", capitan.NewUint32Key("k").Field(5), zap.Uint32("k", 5)},
+		{"uint64", capitan.NewUint64Key("k").Field(6), zap.Uint64("k", 6)},
+		{"float32", capitan.NewFloat32Key("k").Field(1.5), zap.Float32("k", 1.5)},
+		{"float64", capitan.NewFloat64Key("k").Field(2.5), zap.Float64("k", 2.5)},
+		{"bool", capitan.NewBoolKey("k").Field(true), zap.Bool("k", true)},
+		{"time", capitan.NewTimeKey("k").Field(now), zap.Time("k", now)},
+		{"duration", capitan.NewDurationKey("k").Field(time.Second), zap.Duration("k", time.Second)},
+		{"bytes", capitan.NewBytesKey("k").Field([]byte("b")), zap.Binary("k", []byte("b"))},
+		{"error", capitan.NewErrorKey("k").Field(testErr), zap.NamedError("k", testErr)},
+		{"custom", capitan.NewKey[custom]("k", "test.custom").Field(custom{ID: "c"}), zap.Any("k", custom{ID: "c"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FieldToZapField(tt.field)
+			if !got.Equals(tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFieldToZapFieldNil(t *testing.T) {
+	if got := FieldToZapField(nil); got.Type != zapcore.SkipType {
+		t.Errorf("expected skip field, got %+v", got)
+	}
+}
+
+func TestEventFields(t *testing.T) {
+	c := capitan.New(capitan.WithSyncMode())
+	defer c.Shutdown()
+
+	sig := capitan.NewSignal("test.zap", "Test zap signal")
+	key := capitan.NewStringKey("order_id")
+
+	var fields []zap.Field
+	c.Hook(sig, func(_ context.Context, e *capitan.Event) {
+		fields = EventFields(e)
+	})
+
+	c.Emit(context.Background(), sig, key.Field("ORDER-1"))
+
+	if len(fields) != 1 || !fields[0].Equals(zap.String("order_id", "ORDER-1")) {
+		t.Errorf("unexpected fields: %+v", fields)
+	}
+}
+
+type zapCard string
+
+func (c zapCard) LogValue() any { return "****" + string(c[len(c)-4:]) }
+
+func TestLogValuer(t *testing.T) {
+	c := capitan.New(capitan.WithSyncMode())
+	defer c.Shutdown()
+
+	sig := capitan.NewSignal("test.zap.logvaluer", "Test zap LogValuer signal")
+	card := capitan.NewKey[zapCard]("card", "test.Card")
+	want := zap.Any("card", "****1111")
+
+	if got := FieldToZapField(card.Field("4111111111111111")); !got.Equals(want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	var fields []zap.Field
+	c.Hook(sig, func(_ context.Context, e *capitan.Event) {
+		fields = EventFields(e)
+	})
+	c.Emit(context.Background(), sig, card.Field("4111111111111111"))
+
+	if len(fields) != 1 || !fields[0].Equals(want) {
+		t.Errorf("expected LogValue in event fields, got %+v", fields)
+	}
+}
@@ -0,0 +1,224 @@
+package captest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zoobzio/capitan"
+)
+
+// Clock is a manually driven time source for capitan.WithTimeSource, so tests
+// control event timestamps, measured durations, and every internal timer
+// exactly: aggregation windows, Join expiry, worker idle timeouts, emit
+// timeouts, and retry backoff fire only when the clock is advanced past them.
+// Its Now method can also be passed to capitan.WithClock alone.
+// Safe for concurrent use.
+type Clock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter // pending timers and tickers
+}
+
+// NewClock returns a Clock stopped at start.
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+// Now returns the clock's current time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d, firing in order every timer and
+// ticker due by then. Functions passed to AfterFunc run on the calling
+// goroutine before Advance returns.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+	c.advanceTo(target)
+}
+
+// Set moves the clock to t, firing the timers due by then as Advance does.
+// Moving the clock backward fires nothing.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	if !t.After(c.now) {
+		c.now = t
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	c.advanceTo(t)
+}
+
+// Pending returns the number of timers and tickers waiting to fire. Tests
+// use it to wait until a goroutine has started its timer before advancing.
+func (c *Clock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// NewTimer returns a timer that fires once the clock reaches d from now.
+func (c *Clock) NewTimer(d time.Duration) capitan.ClockTimer {
+	w := &waiter{clock: c, ch: make(chan time.Time, 1)}
+	c.mu.Lock()
+	c.schedule(w, d)
+	c.mu.Unlock()
+	return w
+}
+
+// AfterFunc returns a timer that calls f once the clock reaches d from now.
+func (c *Clock) AfterFunc(d time.Duration, f func()) capitan.ClockTimer {
+	w := &waiter{clock: c, fn: f}
+	c.mu.Lock()
+	c.schedule(w, d)
+	c.mu.Unlock()
+	return w
+}
+
+// NewTicker returns a ticker that fires every d of clock time. Like
+// time.NewTicker, it panics if d is not positive, and drops ticks the
+// receiver is too slow to take.
+func (c *Clock) NewTicker(d time.Duration) capitan.ClockTicker {
+	if d <= 0 {
+		panic("captest: non-positive interval for NewTicker")
+	}
+	w := &waiter{clock: c, ch: make(chan time.Time, 1), period: d}
+	c.mu.Lock()
+	c.schedule(w, d)
+	c.mu.Unlock()
+	return ticker{w}
+}
+
+// schedule adds w to fire d from now. Timers due immediately fire at once.
+// Must be called while holding c.mu.
+func (c *Clock) schedule(w *waiter, d time.Duration) {
+	w.when = c.now.Add(d)
+	if d <= 0 && w.period == 0 {
+		w.fire(c.now, true)
+		return
+	}
+	c.waiters = append(c.waiters, w)
+}
+
+// remove unschedules w, reporting whether it was pending.
+// Must be called while holding c.mu.
+func (c *Clock) remove(w *waiter) bool {
+	for i, pending := range c.waiters {
+		if pending == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// advanceTo fires the waiters due by target, earliest first, moving the
+// clock to each firing time and finally to target.
+func (c *Clock) advanceTo(target time.Time) {
+	for {
+		c.mu.Lock()
+		var next *waiter
+		for _, w := range c.waiters {
+			if !w.when.After(target) && (next == nil || w.when.Before(next.when)) {
+				next = w
+			}
+		}
+		if next == nil {
+			if target.After(c.now) {
+				c.now = target
+			}
+			c.mu.Unlock()
+			return
+		}
+
+		c.now = next.when
+		if next.period > 0 {
+			next.when = next.when.Add(next.period)
+		} else {
+			c.remove(next)
+		}
+		if next.fn == nil {
+			// Sent under the lock, so a concurrent Stop or Reset drains it
+			next.fire(c.now, false)
+			c.mu.Unlock()
+			continue
+		}
+		c.mu.Unlock()
+		next.fire(time.Time{}, false)
+	}
+}
+
+// waiter is a Clock's timer or ticker.
+type waiter struct {
+	clock  *Clock
+	when   time.Time
+	period time.Duration // non-zero for tickers
+	ch     chan time.Time
+	fn     func()
+}
+
+// fire delivers the firing time without blocking, or calls the AfterFunc
+// function. async runs the function on its own goroutine, for timers that
+// fire while their creator may hold locks the function needs.
+func (w *waiter) fire(at time.Time, async bool) {
+	switch {
+	case w.fn != nil && async:
+		go w.fn()
+	case w.fn != nil:
+		w.fn()
+	default:
+		select {
+		case w.ch <- at:
+		default:
+		}
+	}
+}
+
+// C returns the channel the firing time is sent on; nil for AfterFunc timers.
+func (w *waiter) C() <-chan time.Time {
+	return w.ch
+}
+
+// Stop prevents the timer from firing, reporting whether it was pending.
+// As with time.Timer, no stale firing is received after Stop returns.
+func (w *waiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	w.drain()
+	return w.clock.remove(w)
+}
+
+// Reset reschedules the timer to fire d from now, reporting whether it was
+// pending. As with time.Timer, no stale firing is received after Reset.
+func (w *waiter) Reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	w.drain()
+	pending := w.clock.remove(w)
+	w.clock.schedule(w, d)
+	return pending
+}
+
+// drain discards an undelivered firing.
+func (w *waiter) drain() {
+	if w.ch == nil {
+		return
+	}
+	select {
+	case <-w.ch:
+	default:
+	}
+}
+
+// ticker adapts a periodic waiter to capitan.ClockTicker.
+type ticker struct{ *waiter }
+
+// Stop turns the ticker off. No more ticks are sent after it returns.
+func (t ticker) Stop() {
+	t.waiter.Stop()
+}
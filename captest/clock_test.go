@@ -0,0 +1,171 @@
+package captest
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/capitan"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	clock := NewClock(start)
+
+	var took time.Duration
+	c := capitan.New(
+		capitan.WithSyncMode(),
+		capitan.WithClock(clock.Now),
+		capitan.WithSlowListenerThreshold(time.Second, func(_ capitan.Signal, _ string, d time.Duration) {
+			took = d
+		}),
+	)
+	defer c.Shutdown()
+
+	rec := NewRecorder(t, c, orderCreated)
+	c.Hook(orderCreated, func(context.Context, *capitan.Event) {
+		clock.Advance(2 * time.Second)
+	})
+
+	c.Emit(context.Background(), orderCreated)
+
+	events := rec.Events()
+	if len(events) != 1 || !events[0].Timestamp().Equal(start) {
+		t.Fatalf("expected timestamp %v, got %v", start, events)
+	}
+	if took != 2*time.Second {
+		t.Errorf("expected slow listener measured on the clock as 2s, got %v", took)
+	}
+
+	clock.Set(start.Add(time.Hour))
+	c.Emit(context.Background(), orderCreated)
+	if got := rec.Events()[1].Timestamp(); !got.Equal(start.Add(time.Hour)) {
+		t.Errorf("expected timestamp from Set, got %v", got)
+	}
+}
+
+func TestClockTimers(t *testing.T) {
+	clock := NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	timer := clock.NewTimer(time.Second)
+	var calls int
+	clock.AfterFunc(2*time.Second, func() { calls++ })
+	ticker := clock.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	clock.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("expected timer not to fire early")
+	default:
+	}
+
+	clock.Advance(time.Millisecond)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("expected timer to fire at its deadline")
+	}
+	select {
+	case <-ticker.C():
+	default:
+		t.Fatal("expected ticker to tick")
+	}
+
+	clock.Advance(time.Second)
+	if calls != 1 {
+		t.Errorf("expected AfterFunc to run during Advance, got %d calls", calls)
+	}
+	if got := clock.Pending(); got != 1 {
+		t.Errorf("expected only the ticker pending, got %d", got)
+	}
+
+	// A stopped timer never fires; a reset one fires at its new deadline
+	stopped := clock.NewTimer(time.Second)
+	if !stopped.Stop() {
+		t.Error("expected Stop to report a pending timer")
+	}
+	timer.Reset(3 * time.Second)
+	clock.Advance(2 * time.Second)
+	select {
+	case <-stopped.C():
+		t.Error("expected stopped timer not to fire")
+	case <-timer.C():
+		t.Error("expected reset timer not to fire before its new deadline")
+	default:
+	}
+	clock.Advance(time.Second)
+	select {
+	case <-timer.C():
+	default:
+		t.Error("expected reset timer to fire")
+	}
+}
+
+// TestClockDrivesInternalTimers verifies aggregation windows, Join expiry, and
+// worker idle timeouts run on the clock.
+func TestClockDrivesInternalTimers(t *testing.T) {
+	clock := NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	var expired atomic.Int32
+	c := capitan.New(
+		capitan.WithTimeSource(clock),
+		capitan.WithWorkerIdleTimeout(time.Hour),
+		capitan.WithDropHandler(func(_ capitan.Signal, reason capitan.DropReason) {
+			if reason == capitan.DropReasonExpired {
+				expired.Add(1)
+			}
+		}),
+	)
+	defer c.Shutdown()
+
+	total := capitan.NewFloat64Key("total")
+	summary := capitan.NewSignal("captest.order.summary", "Test order summary signal")
+	joined := capitan.NewSignal("captest.order.joined", "Test order joined signal")
+
+	stopAggregate := capitan.Aggregate(c, orderCreated, total, time.Minute, summary)
+	defer stopAggregate()
+	stopJoin := capitan.Join(c, orderCreated, orderPaid, orderID, 30*time.Second, joined)
+	defer stopJoin()
+	rec := NewRecorder(t, c, summary)
+
+	c.Emit(context.Background(), orderCreated, total.Field(10), orderID.Field("A1"))
+	c.Emit(context.Background(), orderCreated, total.Field(5))
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Join expiry fires on the clock, not on real time
+	clock.Advance(29 * time.Second)
+	if expired.Load() != 0 {
+		t.Fatal("expected pending join entry to survive before its window ends")
+	}
+	clock.Advance(time.Second)
+	if got := expired.Load(); got != 1 {
+		t.Errorf("expected join entry expired on Advance, got %d", got)
+	}
+
+	// The aggregation window closes on the clock
+	clock.Advance(30 * time.Second)
+	rec.WaitFor(t, 1, time.Second)
+	if !rec.AssertEmitted(t, summary, Field(capitan.AggregateCountKey, uint64(2)), Field(capitan.AggregateSumKey, 15.0)) {
+		return
+	}
+
+	// Idle workers retire only once the clock passes the timeout
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	workers := c.Stats().ActiveWorkers
+	if workers == 0 {
+		t.Fatal("expected workers running before the idle timeout")
+	}
+	clock.Advance(time.Hour)
+	deadline := time.Now().Add(time.Second)
+	for c.Stats().ActiveWorkers != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected idle workers retired after Advance, %d still active", c.Stats().ActiveWorkers)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
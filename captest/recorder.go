@@ -0,0 +1,164 @@
+// Package captest provides helpers for testing code that emits capitan events.
+package captest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/capitan"
+)
+
+// Recorder collects snapshots of the events emitted on a Capitan instance so
+// tests can wait for and assert on them. Safe for concurrent use.
+type Recorder struct {
+	observer *capitan.Observer
+
+	mu      sync.Mutex
+	events  []capitan.EventSnapshot
+	changed chan struct{} // closed and replaced whenever an event is recorded
+}
+
+// NewRecorder starts recording events on c. If signals are provided, only
+// those signals are recorded; otherwise every signal is. It works in both
+// sync and async modes, and stops recording when the test finishes.
+func NewRecorder(t testing.TB, c *capitan.Capitan, signals ...capitan.Signal) *Recorder {
+	t.Helper()
+	r := &Recorder{changed: make(chan struct{})}
+	r.observer = c.Observe(r.record, signals...)
+	t.Cleanup(r.observer.Close)
+	return r
+}
+
+// record stores a snapshot of e and wakes any WaitFor callers.
+func (r *Recorder) record(_ context.Context, e *capitan.Event) {
+	snap := e.Snapshot()
+	r.mu.Lock()
+	r.events = append(r.events, snap)
+	close(r.changed)
+	r.changed = make(chan struct{})
+	r.mu.Unlock()
+}
+
+// Events returns the recorded events in the order they were delivered.
+// Returns a defensive copy; modifications don't affect the recorder.
+func (r *Recorder) Events() []capitan.EventSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make([]capitan.EventSnapshot, len(r.events))
+	copy(result, r.events)
+	return result
+}
+
+// Reset discards the recorded events.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// WaitFor blocks until at least n events have been recorded, failing the
+// test if that takes longer than timeout.
+func (r *Recorder) WaitFor(t testing.TB, n int, timeout time.Duration) {
+	t.Helper()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		r.mu.Lock()
+		got, changed := len(r.events), r.changed
+		r.mu.Unlock()
+		if got >= n {
+			return
+		}
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			t.Fatalf("captest: timed out after %v waiting for %d events, got %d", timeout, n, got)
+			return
+		}
+	}
+}
+
+// FieldMatcher checks one field of a recorded event.
+type FieldMatcher struct {
+	desc  string
+	match func(capitan.EventSnapshot) bool
+}
+
+// Field matches events whose field for key has a value equal to want.
+func Field(key capitan.Key, want any) FieldMatcher {
+	return FieldMatcher{
+		desc: fmt.Sprintf("%s=%v", key.Name(), want),
+		match: func(s capitan.EventSnapshot) bool {
+			f := s.Get(key)
+			return f != nil && reflect.DeepEqual(f.Value(), want)
+		},
+	}
+}
+
+// HasField matches events that have a field for key, whatever its value.
+func HasField(key capitan.Key) FieldMatcher {
+	return FieldMatcher{
+		desc: key.Name() + " present",
+		match: func(s capitan.EventSnapshot) bool {
+			return s.Get(key) != nil
+		},
+	}
+}
+
+// AssertEmitted reports a test error unless some recorded event on signal
+// satisfies every matcher. With no matchers, any event on signal passes.
+// It checks the events recorded so far; call WaitFor first for async delivery.
+func (r *Recorder) AssertEmitted(t testing.TB, signal capitan.Signal, matchers ...FieldMatcher) bool {
+	t.Helper()
+	var seen []string
+	for _, e := range r.Events() {
+		if e.Signal() != signal {
+			continue
+		}
+		if matchesAll(e, matchers) {
+			return true
+		}
+		seen = append(seen, describe(e))
+	}
+
+	want := make([]string, len(matchers))
+	for i, m := range matchers {
+		want[i] = m.desc
+	}
+	if len(seen) == 0 {
+		t.Errorf("captest: no %s event recorded, want [%s]", signal.Name(), strings.Join(want, " "))
+	} else {
+		t.Errorf("captest: no %s event matched [%s]; recorded:\n\t%s",
+			signal.Name(), strings.Join(want, " "), strings.Join(seen, "\n\t"))
+	}
+	return false
+}
+
+// matchesAll reports whether e satisfies every matcher.
+func matchesAll(e capitan.EventSnapshot, matchers []FieldMatcher) bool {
+	for _, m := range matchers {
+		if !m.match(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// describe renders a snapshot's fields in name order for failure messages.
+func describe(e capitan.EventSnapshot) string {
+	fields := e.Fields()
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = fmt.Sprintf("%s=%v", f.Key().Name(), f.Value())
+	}
+	slices.Sort(parts)
+	return "{" + strings.Join(parts, " ") + "}"
+}
@@ -0,0 +1,141 @@
+package captest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/capitan"
+)
+
+var (
+	orderCreated = capitan.NewSignal("captest.order.created", "Test order created signal")
+	orderPaid    = capitan.NewSignal("captest.order.paid", "Test order paid signal")
+	orderID      = capitan.NewStringKey("order_id")
+	amount       = capitan.NewIntKey("amount")
+)
+
+// fakeT captures failures so assertion failures can be tested.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRecorderSyncMode(t *testing.T) {
+	c := capitan.New(capitan.WithSyncMode())
+	defer c.Shutdown()
+
+	rec := NewRecorder(t, c)
+	c.Emit(context.Background(), orderCreated, orderID.Field("A1"), amount.Field(100))
+	c.Emit(context.Background(), orderPaid, orderID.Field("A1"))
+
+	events := rec.Events()
+	if len(events) != 2 || events[0].Signal() != orderCreated || events[1].Signal() != orderPaid {
+		t.Fatalf("expected both events in order, got %d", len(events))
+	}
+	rec.AssertEmitted(t, orderCreated, Field(orderID, "A1"), Field(amount, 100))
+	rec.AssertEmitted(t, orderPaid, HasField(orderID))
+
+	rec.Reset()
+	if got := len(rec.Events()); got != 0 {
+		t.Errorf("expected no events after Reset, got %d", got)
+	}
+}
+
+func TestRecorderAsyncMode(t *testing.T) {
+	c := capitan.New()
+	defer c.Shutdown()
+
+	rec := NewRecorder(t, c, orderPaid)
+	for i := range 10 {
+		c.Emit(context.Background(), orderPaid, amount.Field(i))
+	}
+	c.Emit(context.Background(), orderCreated, amount.Field(99))
+
+	rec.WaitFor(t, 10, time.Second)
+	rec.AssertEmitted(t, orderPaid, Field(amount, 9))
+
+	c.Shutdown()
+	for _, e := range rec.Events() {
+		if e.Signal() != orderPaid {
+			t.Errorf("expected only whitelisted signal, got %s", e.Signal().Name())
+		}
+	}
+}
+
+func TestRecorderAssertEmittedFailure(t *testing.T) {
+	c := capitan.New(capitan.WithSyncMode())
+	defer c.Shutdown()
+
+	rec := NewRecorder(t, c)
+	c.Emit(context.Background(), orderCreated, orderID.Field("A1"))
+
+	ft := &fakeT{TB: t}
+	if rec.AssertEmitted(ft, orderCreated, Field(orderID, "B2")) {
+		t.Error("expected mismatched field to fail")
+	}
+	if rec.AssertEmitted(ft, orderPaid) {
+		t.Error("expected missing signal to fail")
+	}
+	if len(ft.errors) != 2 {
+		t.Fatalf("expected 2 reported errors, got %v", ft.errors)
+	}
+	if want := "recorded:\n\t{order_id=A1}"; !strings.Contains(ft.errors[0], want) {
+		t.Errorf("expected failure to list recorded events, got %q", ft.errors[0])
+	}
+}
+
+func TestRecorderStopsOnCleanup(t *testing.T) {
+	c := capitan.New(capitan.WithSyncMode())
+	defer c.Shutdown()
+
+	var rec *Recorder
+	t.Run("scoped", func(t *testing.T) {
+		rec = NewRecorder(t, c)
+	})
+	c.Emit(context.Background(), orderCreated)
+
+	if got := len(rec.Events()); got != 0 {
+		t.Errorf("expected recorder closed after its test, got %d events", got)
+	}
+}
+
+// TestWorkerMultipleSignalsIsolated is the worker isolation test from the
+// capitan package, rewritten with a Recorder in place of hand-rolled
+// counters, mutex, and WaitGroup.
+func TestWorkerMultipleSignalsIsolated(t *testing.T) {
+	c := capitan.New()
+	defer c.Shutdown()
+
+	sig1 := capitan.NewSignal("test.worker.iso1", "Test worker isolation signal 1")
+	sig2 := capitan.NewSignal("test.worker.iso2", "Test worker isolation signal 2")
+	key := capitan.NewStringKey("value")
+
+	rec := NewRecorder(t, c, sig1, sig2)
+
+	c.Emit(context.Background(), sig1, key.Field("first"))
+	c.Emit(context.Background(), sig1, key.Field("second"))
+	c.Emit(context.Background(), sig2, key.Field("third"))
+
+	rec.WaitFor(t, 3, time.Second)
+
+	counts := make(map[capitan.Signal]int)
+	for _, e := range rec.Events() {
+		counts[e.Signal()]++
+	}
+	if counts[sig1] != 2 {
+		t.Errorf("sig1: expected 2 events, got %d", counts[sig1])
+	}
+	if counts[sig2] != 1 {
+		t.Errorf("sig2: expected 1 event, got %d", counts[sig2])
+	}
+	rec.AssertEmitted(t, sig2, Field(key, "third"))
+}
@@ -0,0 +1,52 @@
+package capitan
+
+import (
+	"context"
+	"time"
+)
+
+// eventCause identifies the event that caused another. It copies the cause's
+// identity rather than referencing it, as the cause is returned to its pool.
+type eventCause struct {
+	signal    Signal
+	timestamp time.Time
+	sequence  uint64
+}
+
+// EmitCaused emits an Info-severity event on the default instance, recording
+// cause as the event that caused it.
+func EmitCaused(ctx context.Context, cause *Event, signal Signal, fields ...Field) {
+	defaultInstance().EmitCaused(ctx, cause, signal, fields...)
+}
+
+// EmitCaused dispatches an Info-severity event like Emit, recording cause as
+// the event that caused it, typically the event a listener is handling.
+// The new event keeps only the cause's signal, timestamp, and sequence, read
+// back with Cause and CauseSequence, so the causal chain of a flow can be
+// rebuilt from an observer by matching each CauseSequence to a Sequence.
+// A nil cause emits an event without one.
+func (c *Capitan) EmitCaused(ctx context.Context, cause *Event, signal Signal, fields ...Field) {
+	var ref *eventCause
+	if cause != nil {
+		ref = &eventCause{signal: cause.signal, timestamp: cause.timestamp, sequence: cause.sequence}
+	}
+	_ = c.emit(ctx, ref, signal, SeverityInfo, fields...) //nolint:errcheck // Rejections are reported to the error handler
+}
+
+// Cause returns the signal and timestamp of the event that caused this one.
+// ok is false unless the event was emitted with EmitCaused.
+func (e *Event) Cause() (signal Signal, timestamp time.Time, ok bool) {
+	if e.cause == nil {
+		return Signal{}, time.Time{}, false
+	}
+	return e.cause.signal, e.cause.timestamp, true
+}
+
+// CauseSequence returns the Sequence of the event that caused this one,
+// or 0 if the event was not emitted with EmitCaused.
+func (e *Event) CauseSequence() uint64 {
+	if e.cause == nil {
+		return 0
+	}
+	return e.cause.sequence
+}
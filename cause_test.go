@@ -0,0 +1,101 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmitCaused(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	order := NewSignal("test.cause.order", "Test cause order signal")
+	payment := NewSignal("test.cause.payment", "Test cause payment signal")
+	receipt := NewSignal("test.cause.receipt", "Test cause receipt signal")
+
+	// Each listener emits the next event in the flow, caused by the one it handles
+	c.Hook(order, func(ctx context.Context, e *Event) { c.EmitCaused(ctx, e, payment) })
+	c.Hook(payment, func(ctx context.Context, e *Event) { c.EmitCaused(ctx, e, receipt) })
+
+	var mu sync.Mutex
+	var events []EventSnapshot
+	done := make(chan struct{})
+	observer := c.Observe(func(_ context.Context, e *Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e.Snapshot())
+		if len(events) == 3 {
+			close(done)
+		}
+	}, order, payment, receipt)
+	defer observer.Close()
+
+	c.Emit(context.Background(), order)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the causal chain")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	bySignal := make(map[Signal]EventSnapshot)
+	for _, e := range events {
+		bySignal[e.Signal()] = e
+	}
+
+	if _, _, ok := bySignal[order].Cause(); ok {
+		t.Error("expected root event to have no cause")
+	}
+	for child, parent := range map[Signal]Signal{payment: order, receipt: payment} {
+		signal, ts, ok := bySignal[child].Cause()
+		if !ok || signal != parent || !ts.Equal(bySignal[parent].Timestamp()) {
+			t.Errorf("expected %s caused by %s, got %v %v %v", child.Name(), parent.Name(), signal.Name(), ts, ok)
+		}
+		if got := bySignal[child].CauseSequence(); got != bySignal[parent].Sequence() {
+			t.Errorf("expected %s cause sequence %d, got %d", child.Name(), bySignal[parent].Sequence(), got)
+		}
+	}
+}
+
+func TestEmitCausedNil(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.cause.nil", "Test nil cause signal")
+	var hasCause bool
+	var causeSeq uint64
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		_, _, hasCause = e.Cause()
+		causeSeq = e.CauseSequence()
+	})
+
+	c.EmitCaused(context.Background(), nil, sig)
+	if hasCause || causeSeq != 0 {
+		t.Errorf("expected no cause, got %v %d", hasCause, causeSeq)
+	}
+}
+
+// TestEmitCausedPooled verifies a recycled event doesn't keep a stale cause.
+func TestEmitCausedPooled(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	parent := NewSignal("test.cause.parent", "Test cause parent signal")
+	child := NewSignal("test.cause.child", "Test cause child signal")
+
+	var caused []bool
+	c.Hook(parent, func(ctx context.Context, e *Event) { c.EmitCaused(ctx, e, child) })
+	c.Hook(child, func(_ context.Context, e *Event) {
+		_, _, ok := e.Cause()
+		caused = append(caused, ok)
+	})
+
+	c.Emit(context.Background(), parent)
+	c.Emit(context.Background(), child)
+	if len(caused) != 2 || !caused[0] || caused[1] {
+		t.Errorf("expected only the first child to have a cause, got %v", caused)
+	}
+}
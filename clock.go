@@ -0,0 +1,66 @@
+package capitan
+
+import "time"
+
+// Clock is a time source with timers, set with WithTimeSource. It lets tests
+// drive every internal wait, not just timestamps; captest.Clock implements it.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) ClockTimer
+	NewTicker(d time.Duration) ClockTicker
+	AfterFunc(d time.Duration, f func()) ClockTimer
+}
+
+// ClockTimer is a single-shot timer created by a Clock, mirroring time.Timer.
+type ClockTimer interface {
+	// C returns the channel the time is sent on when the timer fires.
+	// It is nil for timers created by AfterFunc.
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// ClockTicker is a periodic timer created by a Clock, mirroring time.Ticker.
+type ClockTicker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// systemClock is the default Clock, backed by the time package.
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer wraps time.NewTimer.
+func (systemClock) NewTimer(d time.Duration) ClockTimer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+// NewTicker wraps time.NewTicker.
+func (systemClock) NewTicker(d time.Duration) ClockTicker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// AfterFunc wraps time.AfterFunc.
+func (systemClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	return systemTimer{time.AfterFunc(d, f)}
+}
+
+// systemTimer adapts *time.Timer to ClockTimer.
+type systemTimer struct{ *time.Timer }
+
+// C returns the timer's channel.
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// systemTicker adapts *time.Ticker to ClockTicker.
+type systemTicker struct{ *time.Ticker }
+
+// C returns the ticker's channel.
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
@@ -0,0 +1,169 @@
+package capitan
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// Variants of the composite keys defined in this file.
+const (
+	VariantURL  Variant = "*url.URL"
+	VariantUUID Variant = "capitan.UUID"
+	VariantJSON Variant = "json"
+)
+
+// URLKey is a key for *url.URL values. Events render its fields as the URL string.
+type URLKey struct {
+	GenericKey[*url.URL]
+}
+
+// NewURLKey creates a URLKey with the given name.
+func NewURLKey(name string) URLKey {
+	return URLKey{NewKey[*url.URL](name, VariantURL)}
+}
+
+// Parse parses raw as an absolute URL and returns a field holding it,
+// or an error wrapping ErrInvalidURL.
+func (k URLKey) Parse(raw string) (Field, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("%w: %q is not absolute", ErrInvalidURL, raw)
+	}
+	return k.Field(u), nil
+}
+
+// UUID is a 128-bit universally unique identifier. Identifiers from other
+// packages with the same [16]byte layout convert directly, e.g. capitan.UUID(id).
+type UUID [16]byte
+
+// ParseUUID parses a UUID in canonical form, such as
+// "123e4567-e89b-12d3-a456-426614174000", case-insensitively.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("%w: %q", ErrInvalidUUID, s)
+	}
+	compact := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	if _, err := hex.Decode(u[:], []byte(compact)); err != nil {
+		return UUID{}, fmt.Errorf("%w: %q", ErrInvalidUUID, s)
+	}
+	return u, nil
+}
+
+// String returns the UUID in canonical lowercase form.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], u[10:16])
+	return string(buf[:])
+}
+
+// MarshalText renders the UUID in canonical form, so it encodes as a JSON string.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText parses a UUID in canonical form.
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// UUIDKey is a key for UUID values.
+type UUIDKey struct {
+	GenericKey[UUID]
+}
+
+// NewUUIDKey creates a UUIDKey with the given name.
+func NewUUIDKey(name string) UUIDKey {
+	return UUIDKey{NewKey[UUID](name, VariantUUID)}
+}
+
+// Parse parses s with ParseUUID and returns a field holding it.
+func (k UUIDKey) Parse(s string) (Field, error) {
+	u, err := ParseUUID(s)
+	if err != nil {
+		return nil, err
+	}
+	return k.Field(u), nil
+}
+
+// JSONKey is a key for values carried as JSON. Fields hold the typed value,
+// returned by From, and marshal it only when Value is first called, so events
+// nobody renders never pay for encoding.
+type JSONKey[T any] struct {
+	name string
+}
+
+// NewJSONKey creates a JSONKey with the given name.
+func NewJSONKey[T any](name string) JSONKey[T] {
+	return JSONKey[T]{name: name}
+}
+
+// Name returns the semantic identifier.
+func (k JSONKey[T]) Name() string { return k.name }
+
+// Variant returns VariantJSON.
+func (k JSONKey[T]) Variant() Variant { return VariantJSON }
+
+// Field creates a field holding value.
+func (k JSONKey[T]) Field(value T) Field {
+	return jsonField[T]{key: k, value: value, state: &jsonState{}}
+}
+
+// From extracts the typed value for this key from the event.
+// Returns the value and true if present, or zero value and false if not present or wrong type.
+func (k JSONKey[T]) From(e *Event) (T, bool) {
+	if f, ok := e.Get(k).(jsonField[T]); ok {
+		return f.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// jsonState holds the once-marshaled encoding shared by copies of a jsonField.
+type jsonState struct {
+	once sync.Once
+	data json.RawMessage
+}
+
+// jsonField is a Field holding a value that is marshaled to JSON on first read.
+type jsonField[T any] struct {
+	key   JSONKey[T]
+	value T
+	state *jsonState
+}
+
+// Variant returns VariantJSON.
+func (f jsonField[T]) Variant() Variant { return VariantJSON }
+
+// Key returns the field's key.
+func (f jsonField[T]) Key() Key { return f.key }
+
+// Value returns the value marshaled as a json.RawMessage, or nil if it
+// cannot be marshaled.
+func (f jsonField[T]) Value() any {
+	f.state.once.Do(func() {
+		if data, err := json.Marshal(f.value); err == nil {
+			f.state.data = data
+		}
+	})
+	return f.state.data
+}
@@ -0,0 +1,123 @@
+package capitan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestURLKey(t *testing.T) {
+	key := NewURLKey("endpoint")
+
+	field, err := key.Parse("https://example.com/orders?id=1")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if field.Variant() != VariantURL {
+		t.Errorf("expected VariantURL, got %s", field.Variant())
+	}
+
+	for _, raw := range []string{"/relative/path", "://bad"} {
+		if _, err := key.Parse(raw); !errors.Is(err, ErrInvalidURL) {
+			t.Errorf("%q: expected ErrInvalidURL, got %v", raw, err)
+		}
+	}
+
+	e := newEvent(context.Background(), NewSignal("test.url", "Test URL signal"), SeverityInfo, time.Now(), field)
+	u, ok := key.From(e)
+	if !ok || u.Host != "example.com" {
+		t.Errorf("expected parsed URL from event, got %v", u)
+	}
+	if s := e.String(); !strings.Contains(s, "endpoint=https://example.com/orders?id=1") {
+		t.Errorf("expected URL rendered as string, got %s", s)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"endpoint":"https://example.com/orders?id=1"`) {
+		t.Errorf("expected URL as JSON string, got %s", data)
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	const canonical = "123e4567-e89b-12d3-a456-426614174000"
+
+	u, err := ParseUUID(strings.ToUpper(canonical))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if u.String() != canonical {
+		t.Errorf("expected %s, got %s", canonical, u)
+	}
+
+	for _, s := range []string{"", "123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g"} {
+		if _, err := ParseUUID(s); !errors.Is(err, ErrInvalidUUID) {
+			t.Errorf("%q: expected ErrInvalidUUID, got %v", s, err)
+		}
+	}
+}
+
+func TestUUIDKey(t *testing.T) {
+	key := NewUUIDKey("request_id")
+	field, err := key.Parse("123e4567-e89b-12d3-a456-426614174000")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if _, err := key.Parse("nope"); !errors.Is(err, ErrInvalidUUID) {
+		t.Errorf("expected ErrInvalidUUID, got %v", err)
+	}
+
+	e := newEvent(context.Background(), NewSignal("test.uuid", "Test UUID signal"), SeverityInfo, time.Now(), field)
+	u, ok := key.From(e)
+	if !ok || u[0] != 0x12 || u[15] != 0x00 {
+		t.Errorf("expected UUID from event, got %v", u)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"request_id":"123e4567-e89b-12d3-a456-426614174000"`) {
+		t.Errorf("expected UUID as JSON string, got %s", data)
+	}
+}
+
+func TestJSONKey(t *testing.T) {
+	type order struct {
+		ID    string  `json:"id"`
+		Total float64 `json:"total"`
+	}
+	key := NewJSONKey[order]("order")
+	field := key.Field(order{ID: "A-1", Total: 9.5})
+
+	if field.Variant() != VariantJSON || field.Key().Name() != "order" {
+		t.Errorf("unexpected field metadata: %s %s", field.Variant(), field.Key().Name())
+	}
+
+	e := newEvent(context.Background(), NewSignal("test.json", "Test JSON signal"), SeverityInfo, time.Now(), field)
+	got, ok := key.From(e)
+	if !ok || got.ID != "A-1" || got.Total != 9.5 {
+		t.Errorf("expected typed struct from event, got %+v", got)
+	}
+	if _, ok := NewJSONKey[string]("order").From(e); ok {
+		t.Error("expected From with a different type to fail")
+	}
+
+	raw, ok := field.Value().(json.RawMessage)
+	if !ok || string(raw) != `{"id":"A-1","total":9.5}` {
+		t.Errorf("expected marshaled bytes, got %v", field.Value())
+	}
+	if s := e.String(); !strings.Contains(s, `order={"id":"A-1","total":9.5}`) {
+		t.Errorf("expected JSON rendered inline, got %s", s)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"order":{"id":"A-1","total":9.5}`) {
+		t.Errorf("expected embedded JSON object, got %s", data)
+	}
+}
@@ -1,6 +1,11 @@
 package capitan
 
-import "sync"
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
 
 var (
 	defaultOptions []Option
@@ -10,10 +15,106 @@ var (
 // Option configures a Capitan instance.
 type Option func(*Capitan)
 
+// SlowListenerHandler is called when a listener takes longer than the
+// configured threshold to handle an event. listenerName falls back to the
+// listener function's name when the listener is unnamed.
+type SlowListenerHandler func(signal Signal, listenerName string, took time.Duration)
+
 // PanicHandler is called when a listener panics during event processing.
 // Receives the signal being processed and the recovered panic value.
 type PanicHandler func(signal Signal, recovered any)
 
+// PreEmitFunc validates or enriches an event's fields on the emitting
+// goroutine before it is queued. Returned fields replace the outgoing fields;
+// a non-nil error aborts the emission.
+type PreEmitFunc func(ctx context.Context, fields []Field) ([]Field, error)
+
+// DropHandler is called when an event is discarded before reaching its listeners.
+// Receives the signal and the reason the event was dropped.
+type DropHandler func(signal Signal, reason DropReason)
+
+// ErrorHandler is called when an error-returning listener fails and the
+// error is not retried (or retries are exhausted).
+type ErrorHandler func(signal Signal, err error)
+
+// ErrorPolicy determines how errors from HookE listeners are handled.
+type ErrorPolicy string
+
+const (
+	// ErrorPolicyDeadLetter reports errors to the error handler. This is the default.
+	ErrorPolicyDeadLetter ErrorPolicy = "dead-letter"
+
+	// ErrorPolicyRetry redelivers the event to the failed listener up to the
+	// configured maximum retries, then reports the error to the error handler.
+	ErrorPolicyRetry ErrorPolicy = "retry"
+
+	// ErrorPolicyIgnore discards errors.
+	ErrorPolicyIgnore ErrorPolicy = "ignore"
+)
+
+// Default Health thresholds.
+const (
+	defaultStuckAfter  = 5 * time.Second
+	defaultMaxEventAge = time.Minute
+)
+
+// RetryPolicy configures per-listener retries for HookWithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of deliveries, including the first.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// Multiplier scales the delay after each retry. Values below 1 keep it constant.
+	Multiplier float64
+
+	// MaxBackoff caps the delay. Zero means no cap.
+	MaxBackoff time.Duration
+}
+
+// Backoff returns the delay before retrying after the given failed attempt (starting at 1).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff)
+	if p.Multiplier > 1 {
+		for i := 1; i < attempt; i++ {
+			delay *= p.Multiplier
+			if p.MaxBackoff > 0 && delay >= float64(p.MaxBackoff) {
+				break
+			}
+		}
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// defaultMaxRetries is the retry limit used by ErrorPolicyRetry unless configured.
+const defaultMaxRetries = 3
+
+// invalid records a configuration error reported by NewValidated.
+// New discards these, keeping its lenient handling of invalid options.
+func (c *Capitan) invalid(format string, args ...any) {
+	c.optionErrs = append(c.optionErrs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidOption}, args...)...))
+}
+
+// validateOptions records conflicts between options, once all are applied.
+func (c *Capitan) validateOptions() {
+	if !c.syncMode {
+		return
+	}
+	if c.inFlightCap != nil {
+		c.invalid("WithMaxInFlight has no effect with WithSyncMode")
+	}
+	if c.emitTimeout > 0 {
+		c.invalid("WithEmitTimeout has no effect with WithSyncMode")
+	}
+	if c.idleTimeout > 0 {
+		c.invalid("WithWorkerIdleTimeout has no effect with WithSyncMode")
+	}
+}
+
 // Configure sets options for the default Capitan instance.
 // Must be called before any module-level functions (Hook, Emit, Observe, Shutdown).
 // Subsequent calls have no effect once the default instance is created.
@@ -27,21 +128,60 @@ func Configure(opts ...Option) {
 // Default is 16. Larger buffers reduce backpressure but increase memory usage.
 func WithBufferSize(size int) Option {
 	return func(c *Capitan) {
-		if size > 0 {
-			c.bufferSize = size
+		if size <= 0 {
+			c.invalid("WithBufferSize: size must be positive, got %d", size)
+			return
 		}
+		c.bufferSize = size
 	}
 }
 
+// SetBufferSize changes the queue buffer size on the default instance.
+func SetBufferSize(size int) {
+	defaultInstance().SetBufferSize(size)
+}
+
+// SetBufferSize changes the queue buffer size for workers created after the
+// call, e.g. to absorb a burst during an incident. Existing workers keep
+// their channel; a signal picks up the new size once its worker is recreated,
+// such as after its last listener closes. Non-positive sizes are ignored.
+// Safe to call while events are being emitted.
+func (c *Capitan) SetBufferSize(size int) {
+	if size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	c.bufferSize = size
+	c.mu.Unlock()
+}
+
 // WithPanicHandler sets a callback to be invoked when a listener panics.
 // The handler receives the signal and the recovered panic value.
 // By default, panics are recovered silently to prevent system crashes.
 func WithPanicHandler(handler PanicHandler) Option {
 	return func(c *Capitan) {
+		if handler == nil {
+			c.invalid("WithPanicHandler: handler is nil")
+		}
 		c.panicHandler = handler
 	}
 }
 
+// SetPanicHandler replaces the panic handler on the default instance.
+func SetPanicHandler(handler PanicHandler) {
+	defaultInstance().SetPanicHandler(handler)
+}
+
+// SetPanicHandler replaces the callback invoked when a listener panics, for
+// example when a debug mode is toggled. A nil handler restores silent
+// recovery. Takes effect for the next panic recovered and is safe to call
+// while events are being processed.
+func (c *Capitan) SetPanicHandler(handler PanicHandler) {
+	c.mu.Lock()
+	c.panicHandler = handler
+	c.mu.Unlock()
+}
+
 // WithSyncMode enables synchronous event processing for testing.
 // When enabled, Emit() calls listeners directly instead of queueing to workers.
 // This eliminates timing dependencies and makes tests deterministic.
@@ -51,3 +191,458 @@ func WithSyncMode() Option {
 		c.syncMode = true
 	}
 }
+
+// WithCallerInfo records the file and line of the code that emitted each event,
+// available via Event.Caller(). Frames inside capitan are skipped so the
+// reported location is the application call site.
+// Capturing the caller walks the stack on every emit; leave disabled on hot paths.
+func WithCallerInfo() Option {
+	return func(c *Capitan) {
+		c.callerInfo = true
+	}
+}
+
+// WithClock sets the time source used for event timestamps and for the
+// instance's own time readings: processing durations reported to metrics,
+// slow-listener detection, dead-letter and rejection times, health checks,
+// and aggregation windows' start times. Default is time.Now. Useful for
+// freezing time in tests or using a synchronized clock. Timers keep running
+// on real time; use WithTimeSource to drive them too.
+func WithClock(now func() time.Time) Option {
+	return func(c *Capitan) {
+		if now == nil {
+			c.invalid("WithClock: clock is nil")
+			return
+		}
+		c.clock = now
+	}
+}
+
+// WithTimeSource sets the Clock used both for time readings, as WithClock
+// does, and for the instance's timers: aggregation windows, Join expiry,
+// worker idle timeouts, emit timeouts, and retry backoff. With captest.Clock,
+// tests advance all of them by hand instead of sleeping.
+func WithTimeSource(clock Clock) Option {
+	return func(c *Capitan) {
+		if clock == nil {
+			c.invalid("WithTimeSource: clock is nil")
+			return
+		}
+		c.clock = clock.Now
+		c.timers = clock
+	}
+}
+
+// WithWorkerIdleTimeout stops a signal's worker goroutine once it has received
+// no events for d, reclaiming goroutines held by rarely used signals. The
+// worker is removed as when the signal's last listener closes, and the next
+// emit creates a new one. Zero (default) keeps workers until their listeners
+// close or the instance shuts down. The timeout follows the WithTimeSource
+// clock.
+func WithWorkerIdleTimeout(d time.Duration) Option {
+	return func(c *Capitan) {
+		if d < 0 {
+			c.invalid("WithWorkerIdleTimeout: timeout must not be negative, got %v", d)
+			return
+		}
+		c.idleTimeout = d
+	}
+}
+
+// WithMaxInFlight caps the total number of events queued across all workers.
+// When the cap is reached, Emit blocks until capacity frees up, the context is
+// canceled, or the instance shuts down. This bounds memory during emission storms
+// that span many signals. Has no effect in sync mode.
+func WithMaxInFlight(n int) Option {
+	return func(c *Capitan) {
+		if n <= 0 {
+			c.invalid("WithMaxInFlight: limit must be positive, got %d", n)
+		}
+		if n > 0 {
+			c.inFlightCap = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithMinSeverity drops events ranked below threshold before they are queued.
+// Ranking follows the instance's severity order (see WithSeverityOrder).
+// Severities absent from the order are never dropped.
+func WithMinSeverity(threshold Severity) Option {
+	return func(c *Capitan) {
+		c.minSeverity = threshold
+	}
+}
+
+// WithSeverityOrder replaces the severity ranking, lowest first.
+// The default order is DEBUG, INFO, WARN, ERROR. Use it to slot in custom
+// levels emitted via EmitSeverity, for example:
+//
+//	capitan.WithSeverityOrder([]capitan.Severity{"TRACE", capitan.SeverityDebug,
+//	    capitan.SeverityInfo, capitan.SeverityWarn, capitan.SeverityError, "FATAL"})
+func WithSeverityOrder(order []Severity) Option {
+	return func(c *Capitan) {
+		if len(order) == 0 {
+			c.invalid("WithSeverityOrder: order is empty")
+			return
+		}
+		c.severityRanks = severityRanks(order)
+	}
+}
+
+// WithDetachedContext makes events carry a context that keeps the emitter's
+// values but ignores its cancellation and deadline (context.WithoutCancel).
+// Queued events are then processed even if the emitting request finishes first.
+// The emitter's context still bounds how long Emit waits to queue the event.
+// By default, events whose context is canceled while queued are skipped.
+func WithDetachedContext() Option {
+	return func(c *Capitan) {
+		c.detachContext = true
+	}
+}
+
+// WithCanceledEventHandler sets a callback invoked whenever a queued event is
+// skipped because its context was canceled before processing.
+// The handler receives the signal and a copy of the event's fields.
+// Skips are also counted in Stats.CanceledCounts.
+func WithCanceledEventHandler(handler func(signal Signal, fields []Field)) Option {
+	return func(c *Capitan) {
+		c.canceledHandler = handler
+	}
+}
+
+// WithProcessCanceledEvents delivers queued events to listeners even if their
+// context was canceled while queued. Listeners still receive the canceled
+// context and can check ctx.Err() themselves.
+func WithProcessCanceledEvents() Option {
+	return func(c *Capitan) {
+		c.processCanceled = true
+	}
+}
+
+// WithDropHandler sets a callback invoked whenever an event is dropped,
+// for example on emit timeout or context cancellation.
+// Emits to signals without listeners are not reported.
+func WithDropHandler(handler DropHandler) Option {
+	return func(c *Capitan) {
+		c.dropHandler = handler
+	}
+}
+
+// WithEmitTimeout bounds how long Emit waits for queue space before dropping
+// the event with DropReasonTimeout. Zero (the default) waits until the context
+// is canceled or the instance shuts down.
+// No timer is created when the event can be queued immediately.
+func WithEmitTimeout(d time.Duration) Option {
+	return func(c *Capitan) {
+		if d < 0 {
+			c.invalid("WithEmitTimeout: timeout must not be negative, got %s", d)
+		}
+		if d > 0 {
+			c.emitTimeout = d
+		}
+	}
+}
+
+// WithErrorHandler sets a callback invoked when an error-returning listener
+// fails and the error is not retried. Acts as the dead-letter destination.
+func WithErrorHandler(handler ErrorHandler) Option {
+	return func(c *Capitan) {
+		c.errorHandler = handler
+	}
+}
+
+// WithErrorPolicy sets how errors from HookE listeners are handled.
+// Default is ErrorPolicyDeadLetter.
+func WithErrorPolicy(policy ErrorPolicy) Option {
+	return func(c *Capitan) {
+		switch policy {
+		case ErrorPolicyDeadLetter, ErrorPolicyRetry, ErrorPolicyIgnore:
+		default:
+			c.invalid("WithErrorPolicy: unknown policy %q", policy)
+		}
+		c.errorPolicy = policy
+	}
+}
+
+// WithMaxRetries sets how many times ErrorPolicyRetry redelivers an event to a
+// failed listener before reporting the error. Default is 3.
+// Retries are re-enqueued immediately unless a backoff is set via WithRetry,
+// and only reach the failed listener.
+func WithMaxRetries(n int) Option {
+	return func(c *Capitan) {
+		if n < 0 {
+			c.invalid("WithMaxRetries: count must not be negative, got %d", n)
+			return
+		}
+		c.maxRetries = n
+	}
+}
+
+// WithRetry enables ErrorPolicyRetry with up to maxAttempts total deliveries
+// per failing listener. Before each redelivery the event waits backoff(attempt),
+// where attempt is the number of the delivery that just failed; a nil backoff
+// retries immediately. Once attempts are exhausted the error goes to the error handler.
+func WithRetry(maxAttempts int, backoff func(attempt int) time.Duration) Option {
+	return func(c *Capitan) {
+		if maxAttempts < 1 {
+			c.invalid("WithRetry: maxAttempts must be at least 1, got %d", maxAttempts)
+		}
+		c.errorPolicy = ErrorPolicyRetry
+		c.maxRetries = max(maxAttempts-1, 0)
+		c.retryBackoff = backoff
+	}
+}
+
+// ExponentialBackoff returns a backoff function for WithRetry that doubles
+// the delay after each attempt, starting at base and capped at limit.
+func ExponentialBackoff(base, limit time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < limit; i++ {
+			delay *= 2
+		}
+		return min(delay, limit)
+	}
+}
+
+// WithMetricsCollector forwards instrumentation callbacks to mc in addition
+// to the built-in counters that back Stats.
+func WithMetricsCollector(mc MetricsCollector) Option {
+	return func(c *Capitan) {
+		c.collector = mc
+	}
+}
+
+// WithHealthThresholds sets when Health flags a signal: its queue has stayed
+// at capacity for stuckAfter, or its oldest pending event is older than maxAge.
+// A zero duration disables that check. Defaults are 5s and 1m.
+func WithHealthThresholds(stuckAfter, maxAge time.Duration) Option {
+	return func(c *Capitan) {
+		c.stuckAfter = stuckAfter
+		c.maxEventAge = maxAge
+	}
+}
+
+// WithSlowListenerThreshold times every listener invocation and calls handler
+// when one exceeds d. Applies in both async and sync modes. Disabled by default.
+func WithSlowListenerThreshold(d time.Duration, handler SlowListenerHandler) Option {
+	return func(c *Capitan) {
+		c.slowThreshold = d
+		c.slowHandler = handler
+	}
+}
+
+// WithSlowListenerWarning is WithSlowListenerThreshold for callers that only
+// need the signal and elapsed time: fn is called when a single listener
+// invocation exceeds d. The listener still runs to completion.
+func WithSlowListenerWarning(d time.Duration, fn func(signal Signal, elapsed time.Duration)) Option {
+	if fn == nil {
+		return func(c *Capitan) {
+			c.invalid("WithSlowListenerWarning: callback is nil")
+		}
+	}
+	return WithSlowListenerThreshold(d, func(signal Signal, _ string, elapsed time.Duration) {
+		fn(signal, elapsed)
+	})
+}
+
+// WithMaxFields rejects emitted events carrying more than n fields.
+// Rejected events are counted as DropReasonLimit drops and reported to the
+// error handler with ErrTooManyFields. Zero (default) disables the limit.
+func WithMaxFields(n int) Option {
+	return func(c *Capitan) {
+		c.maxFields = n
+	}
+}
+
+// WithMaxBytesFieldSize rejects emitted events whose []byte fields total more
+// than size bytes. Rejected events are counted as DropReasonLimit drops and
+// reported to the error handler with ErrFieldsTooLarge. Zero (default) disables the limit.
+func WithMaxBytesFieldSize(size int) Option {
+	return func(c *Capitan) {
+		c.maxBytesSize = size
+	}
+}
+
+// WithDetailedStats enables per-signal severity counts in
+// Stats.SignalSeverityCounts. Off by default since the nested map costs more
+// on the emit path.
+func WithDetailedStats() Option {
+	return func(c *Capitan) {
+		c.detailedStats = true
+		c.metrics.TrackSignalSeverities()
+	}
+}
+
+// WithQueueDepthTracking samples each worker's queue depth every time it
+// takes an event, reporting the maximum and a 95th percentile estimate in
+// Stats.MaxQueueDepth and Stats.P95QueueDepth. Unlike the QueueDepths
+// snapshot, these reveal bursts, which helps size buffers. Sampling uses
+// atomics only. Disabled by default.
+func WithQueueDepthTracking() Option {
+	return func(c *Capitan) {
+		c.trackQueueDepth = true
+	}
+}
+
+// WithCancelBetweenListeners re-checks the event's context before each
+// listener and skips the remaining listeners once it is canceled. By default
+// the context is only checked before delivery starts, so every listener runs.
+func WithCancelBetweenListeners() Option {
+	return func(c *Capitan) {
+		c.cancelBetween = true
+	}
+}
+
+// WithDeadLetter keeps up to capacity dropped or failed events in an
+// in-memory dead letter queue, evicting the oldest when full. Inspect it with
+// DeadLetters, empty it with DrainDeadLetters, or re-emit with RequeueDeadLetters.
+// Disabled by default.
+func WithDeadLetter(capacity int) Option {
+	return func(c *Capitan) {
+		if capacity <= 0 {
+			c.invalid("WithDeadLetter: capacity must be positive, got %d", capacity)
+			return
+		}
+		c.dlq = &deadLetterQueue{capacity: capacity}
+	}
+}
+
+// WithStrictStructs makes EmitStruct fail with ErrUnsupportedField when a
+// struct field's type has no built-in variant, instead of skipping the field.
+func WithStrictStructs() Option {
+	return func(c *Capitan) {
+		c.strictStructs = true
+	}
+}
+
+// WithConcurrentListeners runs the signal's listeners concurrently, at most
+// limit at a time, instead of one after another. The worker still waits for
+// all listeners before taking the signal's next event, so per-signal ordering
+// between events is preserved. Use for independent, slow listeners.
+func WithConcurrentListeners(signal Signal, limit int) Option {
+	return func(c *Capitan) {
+		if limit < 1 {
+			c.invalid("WithConcurrentListeners: limit for %s must be at least 1, got %d", signal.Name(), limit)
+		}
+		if c.listenerConcurrency == nil {
+			c.listenerConcurrency = make(map[Signal]int)
+		}
+		c.listenerConcurrency[signal] = limit
+	}
+}
+
+// WithMaxEmitDepth cuts feedback loops by limiting how deeply listeners may
+// emit from within listeners: an outermost emit has depth 1, an emit from one
+// of its listeners depth 2, and so on. Emits deeper than n are dropped with
+// DropReasonLoop and reported to the error handler as an *EmitLoopError
+// listing the chain of signals. Depth is tracked through the event context,
+// so listeners must emit with the context they receive. Zero (default) disables it.
+func WithMaxEmitDepth(n int) Option {
+	return func(c *Capitan) {
+		c.maxEmitDepth = n
+	}
+}
+
+// WithPreEmit registers fn to run synchronously at the start of every emit on
+// the signal, before the event is queued. Hooks run in registration order,
+// each receiving the previous hook's fields. An error aborts the emission: it
+// is counted as a DropReasonRejected drop, reported to the error handler, and
+// returned from EmitChecked.
+func WithPreEmit(signal Signal, fn PreEmitFunc) Option {
+	return func(c *Capitan) {
+		if c.preEmit == nil {
+			c.preEmit = make(map[Signal][]PreEmitFunc)
+		}
+		c.preEmit[signal] = append(c.preEmit[signal], fn)
+	}
+}
+
+// WithComputedField derives an extra field for every event emitted on the
+// signal, such as a checksum or a normalized timestamp. compute runs on the
+// emitting goroutine, receives the fields passed to Emit, and its result is
+// appended to them; a nil result adds nothing. It runs as a pre-emit hook, in
+// registration order with any WithPreEmit hooks on the same signal, so the
+// computed field counts toward field limits and duplicate checks.
+func WithComputedField(signal Signal, compute func(fields []Field) Field) Option {
+	return func(c *Capitan) {
+		if compute == nil {
+			c.invalid("WithComputedField: compute for %s must not be nil", signal.Name())
+			return
+		}
+		WithPreEmit(signal, func(_ context.Context, fields []Field) ([]Field, error) {
+			field := compute(fields)
+			if field == nil {
+				return fields, nil
+			}
+			// Cap the slice so appending never writes into the caller's array
+			return append(fields[:len(fields):len(fields)], field), nil
+		})(c)
+	}
+}
+
+// WithRedactedFields masks the named fields when events are rendered for
+// output: Event.String, Event.MarshalJSON, and Event.LogValue (slog) show
+// RedactedValue in their place. The event itself is unchanged, so listeners
+// reading fields with Get, From, or Fields still see the real values.
+// Redaction is a property of rendering, not of the event's data.
+func WithRedactedFields(names ...string) Option {
+	return func(c *Capitan) {
+		if c.redacted == nil {
+			c.redacted = make(map[string]struct{}, len(names))
+		}
+		for _, name := range names {
+			c.redacted[name] = struct{}{}
+		}
+	}
+}
+
+// WithSampling keeps roughly rate (0.0–1.0) of the events emitted on signal
+// and discards the rest before they are queued, so high-volume debug signals
+// can stay wired up in production at low cost. Each event is kept or dropped
+// independently at random. Discarded events are counted in
+// Stats().DropCounts[DropReasonSampled] and reported to the drop handler.
+// Rates outside the range are clamped.
+func WithSampling(signal Signal, rate float64) Option {
+	return func(c *Capitan) {
+		if rate < 0 || rate > 1 {
+			c.invalid("WithSampling: rate for %s must be between 0 and 1, got %g", signal.Name(), rate)
+		}
+		if c.sampling == nil {
+			c.sampling = make(map[Signal]float64)
+		}
+		c.sampling[signal] = min(max(rate, 0), 1)
+	}
+}
+
+// DuplicateFieldPolicy determines what happens when an event is emitted with
+// several fields sharing a name.
+type DuplicateFieldPolicy string
+
+const (
+	// DupKeepLast keeps only the last field with each name. This is the default.
+	DupKeepLast DuplicateFieldPolicy = "keep-last"
+
+	// DupError rejects the event with ErrDuplicateField: it is dropped with
+	// DropReasonDuplicate, sent to the dead letter queue if enabled, and
+	// reported to the error handler.
+	DupError DuplicateFieldPolicy = "error"
+
+	// DupKeepAll keeps every field. Get, From, and Fields still see the last
+	// field with each name; Event.GetAll returns all of them in emit order.
+	DupKeepAll DuplicateFieldPolicy = "keep-all"
+)
+
+// WithDuplicateFieldPolicy sets how fields sharing a name within one event are
+// handled. Default is DupKeepLast, which silently discards the earlier fields;
+// DupError surfaces such bugs instead.
+func WithDuplicateFieldPolicy(policy DuplicateFieldPolicy) Option {
+	return func(c *Capitan) {
+		switch policy {
+		case DupKeepLast, DupError, DupKeepAll:
+		default:
+			c.invalid("WithDuplicateFieldPolicy: unknown policy %q", policy)
+		}
+		c.dupPolicy = policy
+	}
+}
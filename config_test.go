@@ -2,6 +2,9 @@ package capitan
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -165,6 +168,22 @@ func TestStats(t *testing.T) {
 	}
 }
 
+// TestStatsFieldSchemaDeduplicates verifies repeated keys appear once in the schema.
+func TestStatsFieldSchemaDeduplicates(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.schema.dedupe", "Test schema dedupe signal")
+	key := NewStringKey("value")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	c.Emit(context.Background(), sig, key.Field("a"), key.Field("b"), NewIntKey("count").Field(1))
+
+	schema := c.Stats().FieldSchemas[sig]
+	if len(schema) != 2 || !KeyEqual(schema[0], key) {
+		t.Errorf("expected deduplicated schema [value count], got %v", schema)
+	}
+}
+
 // TestMultipleOptions verifies multiple options can be combined.
 func TestMultipleOptions(t *testing.T) {
 	var handlerCalled bool
@@ -236,3 +255,785 @@ func TestWithSyncMode(t *testing.T) {
 		t.Errorf("expected 0 active workers in sync mode, got %d", stats.ActiveWorkers)
 	}
 }
+
+// TestWithClock verifies event timestamps come from the configured clock.
+func TestWithClock(t *testing.T) {
+	fixed := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	c := New(WithClock(func() time.Time { return fixed }), WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.clock", "Test clock signal")
+
+	var timestamp time.Time
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		timestamp = e.Timestamp()
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if !timestamp.Equal(fixed) {
+		t.Errorf("expected timestamp %v, got %v", fixed, timestamp)
+	}
+}
+
+// TestWithClockNil verifies a nil clock keeps the default.
+func TestWithClockNil(t *testing.T) {
+	c := New(WithClock(nil))
+	defer c.Shutdown()
+
+	if c.clock == nil {
+		t.Fatal("expected default clock to be retained")
+	}
+}
+
+// TestWithMaxInFlight verifies Emit blocks once the global cap is reached.
+func TestWithMaxInFlight(t *testing.T) {
+	c := New(WithMaxInFlight(2), WithBufferSize(16))
+
+	sigA := NewSignal("test.inflight.a", "Test in-flight signal A")
+	sigB := NewSignal("test.inflight.b", "Test in-flight signal B")
+
+	block := make(chan struct{})
+	c.Hook(sigA, func(_ context.Context, _ *Event) { <-block })
+	c.Hook(sigB, func(_ context.Context, _ *Event) { <-block })
+
+	// Two events across two signals fill the global budget.
+	c.Emit(context.Background(), sigA)
+	c.Emit(context.Background(), sigB)
+
+	if inFlight := c.Stats().InFlight; inFlight != 2 {
+		t.Errorf("expected 2 in flight, got %d", inFlight)
+	}
+
+	emitted := make(chan struct{})
+	go func() {
+		c.Emit(context.Background(), sigA)
+		close(emitted)
+	}()
+
+	select {
+	case <-emitted:
+		t.Fatal("Emit should block while in-flight cap is reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(block)
+
+	select {
+	case <-emitted:
+	case <-time.After(time.Second):
+		t.Fatal("Emit did not unblock after capacity freed")
+	}
+
+	c.Shutdown()
+
+	if inFlight := c.Stats().InFlight; inFlight != 0 {
+		t.Errorf("expected 0 in flight after shutdown, got %d", inFlight)
+	}
+}
+
+// TestWithMaxInFlightContextCancel verifies a blocked Emit drops on context cancellation.
+func TestWithMaxInFlightContextCancel(t *testing.T) {
+	c := New(WithMaxInFlight(1))
+
+	sig := NewSignal("test.inflight.cancel", "Test in-flight cancel signal")
+
+	block := make(chan struct{})
+	var calls int32
+	var mu sync.Mutex
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-block
+	})
+
+	c.Emit(context.Background(), sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	c.Emit(ctx, sig) // Blocks until timeout, then drops
+
+	close(block)
+	c.Shutdown()
+
+	if calls != 1 {
+		t.Errorf("expected 1 processed event, got %d", calls)
+	}
+}
+
+// TestWithDetachedContext verifies queued events survive emitter cancellation.
+func TestWithDetachedContext(t *testing.T) {
+	c := New(WithDetachedContext())
+
+	sig := NewSignal("test.detached", "Test detached context signal")
+
+	type ctxKey string
+	const requestKey ctxKey = "request_id"
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	var mu sync.Mutex
+	var values []any
+	var errs []error
+
+	c.Hook(sig, func(ctx context.Context, _ *Event) {
+		if ctx.Value(requestKey) == "first" {
+			close(started)
+			<-block
+		}
+		mu.Lock()
+		values = append(values, ctx.Value(requestKey))
+		errs = append(errs, ctx.Err())
+		mu.Unlock()
+	})
+
+	// Occupy the worker so the next event stays queued
+	c.Emit(context.WithValue(context.Background(), requestKey, "first"), sig)
+	<-started
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), requestKey, "second"))
+	c.Emit(ctx, sig)
+	cancel()
+
+	close(block)
+	c.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(values) != 2 {
+		t.Fatalf("expected both events processed, got %d", len(values))
+	}
+	if values[1] != "second" {
+		t.Errorf("expected context value %q, got %v", "second", values[1])
+	}
+	if errs[1] != nil {
+		t.Errorf("expected detached context to ignore cancellation, got %v", errs[1])
+	}
+}
+
+// TestWithEmitTimeout verifies a blocked Emit gives up after the timeout and reports the drop.
+func TestWithEmitTimeout(t *testing.T) {
+	var mu sync.Mutex
+	var drops []DropReason
+
+	c := New(
+		WithBufferSize(1),
+		WithEmitTimeout(20*time.Millisecond),
+		WithDropHandler(func(_ Signal, reason DropReason) {
+			mu.Lock()
+			drops = append(drops, reason)
+			mu.Unlock()
+		}),
+	)
+
+	sig := NewSignal("test.emit.timeout", "Test emit timeout signal")
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	var once sync.Once
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		once.Do(func() { close(started) })
+		<-block
+	})
+
+	c.Emit(context.Background(), sig) // Taken by worker, blocks
+	<-started
+	c.Emit(context.Background(), sig) // Fills buffer
+
+	start := time.Now()
+	c.Emit(context.Background(), sig) // Times out
+	elapsed := time.Since(start)
+
+	if elapsed < 20*time.Millisecond || elapsed > 500*time.Millisecond {
+		t.Errorf("expected Emit to return after ~20ms, took %v", elapsed)
+	}
+
+	mu.Lock()
+	if len(drops) != 1 || drops[0] != DropReasonTimeout {
+		t.Errorf("expected one timeout drop, got %v", drops)
+	}
+	mu.Unlock()
+
+	if count := c.Stats().DropCounts[DropReasonTimeout]; count != 1 {
+		t.Errorf("expected 1 timeout drop in stats, got %d", count)
+	}
+
+	close(block)
+	c.Shutdown()
+}
+
+// TestDropHandlerCanceledContext verifies context cancellation while waiting is reported.
+func TestDropHandlerCanceledContext(t *testing.T) {
+	var mu sync.Mutex
+	var drops []DropReason
+
+	c := New(
+		WithBufferSize(1),
+		WithDropHandler(func(_ Signal, reason DropReason) {
+			mu.Lock()
+			drops = append(drops, reason)
+			mu.Unlock()
+		}),
+	)
+
+	sig := NewSignal("test.drop.canceled", "Test drop canceled signal")
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	var once sync.Once
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		once.Do(func() { close(started) })
+		<-block
+	})
+
+	c.Emit(context.Background(), sig)
+	<-started
+	c.Emit(context.Background(), sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	c.Emit(ctx, sig)
+
+	close(block)
+	c.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(drops) != 1 || drops[0] != DropReasonCanceled {
+		t.Errorf("expected one canceled drop, got %v", drops)
+	}
+}
+
+// TestExponentialBackoff verifies delays double per attempt up to the limit.
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for i, want := range expected {
+		if got := backoff(i + 1); got != want*time.Millisecond {
+			t.Errorf("attempt %d: expected %v, got %v", i+1, want*time.Millisecond, got)
+		}
+	}
+}
+
+// TestWithRetry verifies WithRetry enables the retry policy.
+func TestWithRetry(t *testing.T) {
+	c := New(WithRetry(4, nil))
+	defer c.Shutdown()
+
+	if c.errorPolicy != ErrorPolicyRetry || c.maxRetries != 3 {
+		t.Errorf("expected retry policy with 3 retries, got %s/%d", c.errorPolicy, c.maxRetries)
+	}
+}
+
+func slowTestListener(_ context.Context, _ *Event) {
+	time.Sleep(30 * time.Millisecond)
+}
+
+// TestWithSlowListenerThreshold verifies only listeners exceeding the
+// threshold are reported, in both sync and async modes.
+func TestWithSlowListenerThreshold(t *testing.T) {
+	for _, mode := range []struct {
+		name string
+		opts []Option
+	}{
+		{"sync", []Option{WithSyncMode()}},
+		{"async", nil},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var reported []string
+			var took time.Duration
+
+			opts := append(mode.opts, WithSlowListenerThreshold(10*time.Millisecond,
+				func(_ Signal, name string, d time.Duration) {
+					mu.Lock()
+					reported = append(reported, name)
+					took = d
+					mu.Unlock()
+				}))
+			c := New(opts...)
+			defer c.Shutdown()
+
+			sig := NewSignal("test.slow.listener", "Test slow listener signal")
+			c.Hook(sig, func(_ context.Context, _ *Event) {})
+			c.Hook(sig, slowTestListener)
+
+			c.Emit(context.Background(), sig)
+			if err := c.Flush(context.Background()); err != nil {
+				t.Fatalf("flush failed: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(reported) != 1 {
+				t.Fatalf("expected only the slow listener reported, got %v", reported)
+			}
+			if !strings.HasSuffix(reported[0], "slowTestListener") {
+				t.Errorf("expected function name for unnamed listener, got %q", reported[0])
+			}
+			if took < 30*time.Millisecond {
+				t.Errorf("expected reported duration >= 30ms, got %v", took)
+			}
+		})
+	}
+}
+
+// TestWithSlowListenerWarning verifies the signal and elapsed time callback
+// fires only for listeners exceeding the threshold.
+func TestWithSlowListenerWarning(t *testing.T) {
+	var signals []Signal
+	var took time.Duration
+	c := New(WithSyncMode(), WithSlowListenerWarning(10*time.Millisecond, func(signal Signal, elapsed time.Duration) {
+		signals = append(signals, signal)
+		took = elapsed
+	}))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.slow.warning", "Test slow listener warning signal")
+	var completed bool
+	c.Hook(sig, func(context.Context, *Event) {})
+	c.Hook(sig, func(ctx context.Context, e *Event) {
+		slowTestListener(ctx, e)
+		completed = true
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if len(signals) != 1 || signals[0] != sig {
+		t.Fatalf("expected one warning for %s, got %v", sig.Name(), signals)
+	}
+	if took < 30*time.Millisecond {
+		t.Errorf("expected elapsed >= 30ms, got %v", took)
+	}
+	if !completed {
+		t.Error("expected the slow listener to run to completion")
+	}
+
+	if _, err := NewValidated(WithSlowListenerWarning(time.Second, nil)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected ErrInvalidOption for nil callback, got %v", err)
+	}
+}
+
+// TestWithCancelBetweenListeners verifies listeners after a cancellation are skipped.
+func TestWithCancelBetweenListeners(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		want []int
+	}{
+		{"default runs all", []Option{WithSyncMode()}, []int{1, 2, 3}},
+		{"opt-in stops", []Option{WithSyncMode(), WithCancelBetweenListeners()}, []int{1}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := New(tc.opts...)
+			defer c.Shutdown()
+
+			sig := NewSignal("test.cancel.between", "Test cancel between listeners signal")
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var ran []int
+			c.Hook(sig, func(_ context.Context, _ *Event) {
+				ran = append(ran, 1)
+				cancel()
+			})
+			c.Hook(sig, func(_ context.Context, _ *Event) { ran = append(ran, 2) })
+			c.Hook(sig, func(_ context.Context, _ *Event) { ran = append(ran, 3) })
+
+			c.Emit(ctx, sig)
+
+			if len(ran) != len(tc.want) {
+				t.Fatalf("expected listeners %v, got %v", tc.want, ran)
+			}
+			for i := range ran {
+				if ran[i] != tc.want[i] {
+					t.Errorf("expected listeners %v, got %v", tc.want, ran)
+				}
+			}
+		})
+	}
+}
+
+// TestRetryPolicyBackoff verifies backoff growth and capping.
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 10 * time.Millisecond, Multiplier: 3, MaxBackoff: 100 * time.Millisecond}
+	for attempt, want := range map[int]time.Duration{1: 10, 2: 30, 3: 90, 4: 100, 10: 100} {
+		if got := p.Backoff(attempt); got != want*time.Millisecond {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, want*time.Millisecond, got)
+		}
+	}
+
+	constant := RetryPolicy{InitialBackoff: 5 * time.Millisecond}
+	if got := constant.Backoff(4); got != 5*time.Millisecond {
+		t.Errorf("expected constant backoff without multiplier, got %v", got)
+	}
+}
+
+// TestWithConcurrentListeners verifies listeners of a configured signal run in parallel.
+func TestWithConcurrentListeners(t *testing.T) {
+	sig := NewSignal("test.concurrent.listeners", "Test concurrent listeners signal")
+	c := New(WithSyncMode(), WithConcurrentListeners(sig, 3), WithPanicHandler(func(Signal, any) {}))
+	defer c.Shutdown()
+
+	var mu sync.Mutex
+	calls := 0
+	for i := 0; i < 3; i++ {
+		c.Hook(sig, func(_ context.Context, _ *Event) {
+			time.Sleep(50 * time.Millisecond)
+			mu.Lock()
+			calls++
+			mu.Unlock()
+		})
+	}
+	c.Hook(sig, func(_ context.Context, _ *Event) { panic("recovered per goroutine") })
+
+	start := time.Now()
+	c.Emit(context.Background(), sig)
+	elapsed := time.Since(start)
+
+	if calls != 3 {
+		t.Errorf("expected all listeners to finish before Emit returns, got %d", calls)
+	}
+	if elapsed >= 120*time.Millisecond {
+		t.Errorf("expected ~50ms with concurrent listeners, took %v", elapsed)
+	}
+}
+
+// TestWithConcurrentListenersLimit verifies the semaphore bounds parallelism.
+func TestWithConcurrentListenersLimit(t *testing.T) {
+	sig := NewSignal("test.concurrent.limit", "Test concurrent limit signal")
+	c := New(WithConcurrentListeners(sig, 2))
+	defer c.Shutdown()
+
+	var mu sync.Mutex
+	active, peak := 0, 0
+	for i := 0; i < 5; i++ {
+		c.Hook(sig, func(_ context.Context, _ *Event) {
+			mu.Lock()
+			active++
+			peak = max(peak, active)
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			active--
+			mu.Unlock()
+		})
+	}
+
+	c.Emit(context.Background(), sig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if peak != 2 {
+		t.Errorf("expected peak concurrency 2, got %d", peak)
+	}
+}
+
+// TestWithPreEmitReject verifies a failing hook aborts the emission and is surfaced by EmitChecked.
+func TestWithPreEmitReject(t *testing.T) {
+	sig := NewSignal("test.preemit.reject", "Test pre-emit reject signal")
+	errInvalid := errors.New("missing order id")
+	var reasons []DropReason
+	c := New(
+		WithSyncMode(),
+		WithPreEmit(sig, func(_ context.Context, fields []Field) ([]Field, error) {
+			if len(fields) == 0 {
+				return nil, errInvalid
+			}
+			return fields, nil
+		}),
+		WithDropHandler(func(_ Signal, reason DropReason) { reasons = append(reasons, reason) }),
+	)
+	defer c.Shutdown()
+
+	calls := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { calls++ })
+
+	if err := c.EmitChecked(context.Background(), sig); !errors.Is(err, errInvalid) {
+		t.Errorf("expected hook error, got %v", err)
+	}
+	if err := c.EmitChecked(context.Background(), sig, NewStringKey("order").Field("o-1")); err != nil {
+		t.Errorf("expected accepted emit, got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected only the valid event delivered, got %d", calls)
+	}
+	if len(reasons) != 1 || reasons[0] != DropReasonRejected {
+		t.Errorf("expected one rejected drop, got %v", reasons)
+	}
+}
+
+// TestWithPreEmitBatch verifies pre-emit hooks gate each field set of a batch.
+func TestWithPreEmitBatch(t *testing.T) {
+	sig := NewSignal("test.preemit.batch", "Test pre-emit batch signal")
+	order := NewStringKey("order")
+	var reasons []DropReason
+	c := New(
+		WithSyncMode(),
+		WithPreEmit(sig, func(_ context.Context, fields []Field) ([]Field, error) {
+			if len(fields) == 0 {
+				return nil, errors.New("missing order id")
+			}
+			return fields, nil
+		}),
+		WithDropHandler(func(_ Signal, reason DropReason) { reasons = append(reasons, reason) }),
+	)
+	defer c.Shutdown()
+
+	var got []string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		v, _ := order.From(e)
+		got = append(got, v)
+	})
+
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, {order.Field("o-1")}, nil})
+
+	if len(got) != 1 || got[0] != "o-1" {
+		t.Errorf("expected only the valid field set delivered, got %v", got)
+	}
+	if len(reasons) != 2 || reasons[0] != DropReasonRejected || reasons[1] != DropReasonRejected {
+		t.Errorf("expected two rejected drops, got %v", reasons)
+	}
+}
+
+// TestWithPreEmitEnrich verifies hooks chain and their fields replace the outgoing fields.
+func TestWithPreEmitEnrich(t *testing.T) {
+	sig := NewSignal("test.preemit.enrich", "Test pre-emit enrich signal")
+	region := NewStringKey("region")
+	tenant := NewStringKey("tenant")
+	c := New(
+		WithSyncMode(),
+		WithPreEmit(sig, func(_ context.Context, fields []Field) ([]Field, error) {
+			return append(fields, region.Field("eu")), nil
+		}),
+		WithPreEmit(sig, func(_ context.Context, fields []Field) ([]Field, error) {
+			return append(fields, tenant.Field("acme")), nil
+		}),
+	)
+	defer c.Shutdown()
+
+	var got map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.FieldsMap() })
+	c.Emit(context.Background(), sig, NewStringKey("id").Field("1"))
+
+	if len(got) != 3 || got["region"] != "eu" || got["tenant"] != "acme" {
+		t.Errorf("expected both hooks to add fields, got %v", got)
+	}
+}
+
+// TestWithComputedField verifies the computed field sees the emitted fields
+// and is appended without touching the caller's slice.
+func TestWithComputedField(t *testing.T) {
+	sig := NewSignal("test.computed", "Test computed field signal")
+	body := NewStringKey("body")
+	length := NewIntKey("body_len")
+	c := New(
+		WithSyncMode(),
+		WithComputedField(sig, func(fields []Field) Field {
+			for _, f := range fields {
+				if s, ok := f.Value().(string); ok && f.Key().Name() == "body" {
+					return length.Field(len(s))
+				}
+			}
+			return nil
+		}),
+	)
+	defer c.Shutdown()
+
+	var got []map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = append(got, e.FieldsMap()) })
+
+	fields := make([]Field, 1, 4)
+	fields[0] = body.Field("hello")
+	c.Emit(context.Background(), sig, fields...)
+	c.Emit(context.Background(), sig)
+
+	if len(got) != 2 || got[0]["body_len"] != 5 || len(got[0]) != 2 {
+		t.Fatalf("expected computed body_len 5, got %v", got)
+	}
+	if len(got[1]) != 0 {
+		t.Errorf("expected nil computed field to add nothing, got %v", got[1])
+	}
+	if extended := fields[:2]; extended[1] != nil {
+		t.Errorf("expected caller's backing array untouched, got %v", extended[1])
+	}
+
+	if _, err := NewValidated(WithComputedField(sig, nil)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected ErrInvalidOption for nil compute, got %v", err)
+	}
+}
+
+// TestWithComputedFieldBatch verifies batch events get the computed field.
+func TestWithComputedFieldBatch(t *testing.T) {
+	sig := NewSignal("test.computed.batch", "Test computed field batch signal")
+	count := NewIntKey("field_count")
+	c := New(
+		WithSyncMode(),
+		WithComputedField(sig, func(fields []Field) Field { return count.Field(len(fields)) }),
+	)
+	defer c.Shutdown()
+
+	var got []int
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		v, _ := count.From(e)
+		got = append(got, v)
+	})
+
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, {NewStringKey("a").Field("x")}})
+
+	if len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		t.Errorf("expected computed field on every batch event, got %v", got)
+	}
+}
+
+func TestNewValidated(t *testing.T) {
+	c, err := NewValidated(WithBufferSize(64), WithPanicHandler(func(Signal, any) {}))
+	if err != nil {
+		t.Fatalf("expected valid options to succeed, got %v", err)
+	}
+	if c.bufferSize != 64 {
+		t.Errorf("expected options applied, got buffer size %d", c.bufferSize)
+	}
+	c.Shutdown()
+}
+
+func TestNewValidatedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{"negative buffer size", []Option{WithBufferSize(-1)}, []string{"WithBufferSize: size must be positive, got -1"}},
+		{"nil panic handler", []Option{WithPanicHandler(nil)}, []string{"WithPanicHandler: handler is nil"}},
+		{"nil time source", []Option{WithTimeSource(nil)}, []string{"WithTimeSource: clock is nil"}},
+		{"unknown replay order", []Option{WithReplayOrder("sideways")}, []string{`WithReplayOrder: unknown order "sideways"`}},
+		{"sync mode conflicts", []Option{WithSyncMode(), WithMaxInFlight(10), WithEmitTimeout(time.Second)}, []string{
+			"WithMaxInFlight has no effect with WithSyncMode",
+			"WithEmitTimeout has no effect with WithSyncMode",
+		}},
+		{"every invalid option listed", []Option{WithBufferSize(0), WithClock(nil), WithErrorPolicy("panic")}, []string{
+			"WithBufferSize: size must be positive, got 0",
+			"WithClock: clock is nil",
+			`WithErrorPolicy: unknown policy "panic"`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewValidated(tt.opts...)
+			if c != nil {
+				t.Error("expected no instance on error")
+			}
+			if !errors.Is(err, ErrInvalidOption) {
+				t.Fatalf("expected ErrInvalidOption, got %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error to mention %q, got %q", want, err)
+				}
+			}
+			if got := strings.Count(err.Error(), "capitan: invalid option"); got != len(tt.want) {
+				t.Errorf("expected %d problems, got %d in %q", len(tt.want), got, err)
+			}
+		})
+	}
+}
+
+func TestNewStaysLenient(t *testing.T) {
+	c := New(WithBufferSize(-1), WithClock(nil))
+	defer c.Shutdown()
+
+	if c.bufferSize != 16 || c.clock == nil {
+		t.Errorf("expected invalid options ignored, got buffer size %d", c.bufferSize)
+	}
+	if c.optionErrs != nil {
+		t.Error("expected New not to retain option errors")
+	}
+}
+
+func TestSetPanicHandler(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.set.panic.handler", "Test set panic handler signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) { panic("boom") })
+
+	var mu sync.Mutex
+	counts := map[string]int{}
+	handler := func(name string) PanicHandler {
+		return func(Signal, any) {
+			mu.Lock()
+			counts[name]++
+			mu.Unlock()
+		}
+	}
+
+	c.Emit(context.Background(), sig) // recovered silently
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	c.SetPanicHandler(handler("first"))
+	c.Emit(context.Background(), sig)
+	c.Emit(context.Background(), sig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	// Swapping while events flow must be race-free
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			c.Emit(context.Background(), sig)
+		}
+	}()
+	c.SetPanicHandler(handler("second"))
+	wg.Wait()
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	c.SetPanicHandler(nil)
+	c.Emit(context.Background(), sig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if counts["first"] < 2 || counts["first"]+counts["second"] != 52 {
+		t.Errorf("expected 52 panics split between handlers, got %v", counts)
+	}
+}
+
+func TestSetBufferSize(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	existing := NewSignal("test.set.buffer.existing", "Test set buffer size existing signal")
+	later := NewSignal("test.set.buffer.later", "Test set buffer size later signal")
+	c.Hook(existing, func(_ context.Context, _ *Event) {})
+	c.Hook(later, func(_ context.Context, _ *Event) {})
+	c.Emit(context.Background(), existing)
+
+	c.SetBufferSize(64)
+	c.SetBufferSize(0)
+	c.Emit(context.Background(), existing)
+	c.Emit(context.Background(), later)
+
+	stats := c.Stats()
+	if got := stats.QueueCapacities[existing]; got != 16 {
+		t.Errorf("expected existing worker to keep its buffer of 16, got %d", got)
+	}
+	if got := stats.QueueCapacities[later]; got != 64 {
+		t.Errorf("expected new worker to get buffer of 64, got %d", got)
+	}
+}
@@ -0,0 +1,57 @@
+package capitan
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// CorrelationIDKey is the field WithCorrelationID adds to every event.
+var CorrelationIDKey = NewStringKey("capitan.correlation_id")
+
+// correlationIDKey is the context key holding the correlation ID.
+type correlationIDKey struct{}
+
+// WithCorrelationID tags every event with a correlation ID shared by a chain
+// of emits. The ID is taken from the emit context, or generated when the
+// context has none, and carried both in the CorrelationIDKey field and in the
+// context listeners receive, so listeners that emit with that context
+// propagate it. An explicit CorrelationIDKey field passed to Emit is kept.
+func WithCorrelationID() Option {
+	return func(c *Capitan) {
+		c.correlate = true
+	}
+}
+
+// ContextWithCorrelationID returns a copy of ctx carrying id, for continuing
+// a chain started elsewhere, such as an ID from an incoming request.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFrom returns the correlation ID carried by ctx.
+func CorrelationIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// correlationID returns ctx's correlation ID, generating one and attaching
+// it to the returned context when ctx has none.
+func correlationID(ctx context.Context) (context.Context, string) {
+	if id, ok := CorrelationIDFrom(ctx); ok {
+		return ctx, id
+	}
+	var b [16]byte
+	_, _ = rand.Read(b[:]) //nolint:errcheck // crypto/rand.Read never fails
+	id := hex.EncodeToString(b[:])
+	return ContextWithCorrelationID(ctx, id), id
+}
+
+// withCorrelationField adds the correlation ID field to fields unless one
+// was emitted explicitly. Never modifies the caller's slice.
+func withCorrelationField(fields []Field, id string) []Field {
+	if hasFieldNamed(fields, CorrelationIDKey.Name()) {
+		return fields
+	}
+	return append(fields[:len(fields):len(fields)], CorrelationIDKey.Field(id))
+}
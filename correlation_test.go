@@ -0,0 +1,94 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithCorrelationID(t *testing.T) {
+	c := New(WithCorrelationID())
+	defer c.Shutdown()
+
+	orderPlaced := NewSignal("test.correlation.placed", "Test correlation placed signal")
+	orderShipped := NewSignal("test.correlation.shipped", "Test correlation shipped signal")
+
+	type seen struct {
+		field string
+		ctxID string
+	}
+	placed := make(chan seen, 1)
+	shipped := make(chan seen, 1)
+	record := func(ctx context.Context, e *Event) seen {
+		var s seen
+		if f, ok := e.Get(CorrelationIDKey).(GenericField[string]); ok {
+			s.field = f.Value().(string)
+		}
+		s.ctxID, _ = CorrelationIDFrom(ctx)
+		return s
+	}
+
+	// The listener for A emits B with the context it received
+	c.Hook(orderPlaced, func(ctx context.Context, e *Event) {
+		placed <- record(ctx, e)
+		c.Emit(ctx, orderShipped)
+	})
+	c.Hook(orderShipped, func(ctx context.Context, e *Event) {
+		shipped <- record(ctx, e)
+	})
+
+	c.Emit(context.Background(), orderPlaced)
+
+	var a, b seen
+	for _, ch := range []struct {
+		out *seen
+		in  chan seen
+	}{{&a, placed}, {&b, shipped}} {
+		select {
+		case *ch.out = <-ch.in:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the chain")
+		}
+	}
+
+	if len(a.field) != 32 || a.ctxID != a.field {
+		t.Errorf("expected a generated 32-char hex ID on the field and context, got %+v", a)
+	}
+	if b.field != a.field || b.ctxID != a.field {
+		t.Errorf("expected both events to share ID %q, got %+v", a.field, b)
+	}
+}
+
+func TestWithCorrelationIDExisting(t *testing.T) {
+	c := New(WithSyncMode(), WithCorrelationID())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.correlation.existing", "Test existing correlation signal")
+	var ids []string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		if f, ok := e.Get(CorrelationIDKey).(GenericField[string]); ok {
+			ids = append(ids, f.Value().(string))
+		}
+	})
+
+	// An ID already on the context is reused
+	ctx := ContextWithCorrelationID(context.Background(), "req-42")
+	c.Emit(ctx, sig)
+
+	// An explicit field wins over the context's ID
+	c.Emit(ctx, sig, CorrelationIDKey.Field("explicit"))
+
+	// Separate emits without an ID get distinct ones
+	c.Emit(context.Background(), sig)
+	c.Emit(context.Background(), sig)
+
+	if len(ids) != 4 || ids[0] != "req-42" || ids[1] != "explicit" || ids[2] == ids[3] {
+		t.Errorf("unexpected correlation IDs: %v", ids)
+	}
+}
+
+func TestCorrelationIDFromMissing(t *testing.T) {
+	if id, ok := CorrelationIDFrom(context.Background()); ok || id != "" {
+		t.Errorf("expected no correlation ID, got %q", id)
+	}
+}
@@ -0,0 +1,114 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DeadEvent is an event that was dropped or failed in a listener, retained
+// by the dead letter queue for inspection and reprocessing.
+type DeadEvent struct {
+	Signal   Signal
+	Severity Severity
+	Fields   []Field
+	Reason   DropReason
+	Err      error // listener error or recovered panic; nil for drops
+	Time     time.Time
+
+	// Listener names the failing listener, falling back to its function
+	// name; empty for drops.
+	Listener string
+}
+
+// deadLetterQueue is a bounded FIFO that evicts its oldest entry when full.
+type deadLetterQueue struct {
+	mu       sync.Mutex
+	capacity int
+	events   []DeadEvent
+}
+
+// push appends an entry, evicting the oldest when at capacity.
+func (q *deadLetterQueue) push(dead DeadEvent) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.events) >= q.capacity {
+		copy(q.events, q.events[1:])
+		q.events = q.events[:len(q.events)-1]
+	}
+	q.events = append(q.events, dead)
+}
+
+// snapshot returns a copy of the entries, oldest first, optionally clearing the queue.
+func (q *deadLetterQueue) snapshot(clear bool) []DeadEvent {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	result := make([]DeadEvent, len(q.events))
+	copy(result, q.events)
+	if clear {
+		q.events = q.events[:0]
+	}
+	return result
+}
+
+// deadLetter records an event in the dead letter queue, if enabled.
+// The event's fields are copied, so the event may be returned to its pool afterward.
+// listener is the failing listener, or nil for drops.
+func (c *Capitan) deadLetter(event *Event, listener *Listener, reason DropReason, err error) {
+	if c.dlq == nil {
+		return
+	}
+	var label string
+	if listener != nil {
+		label = listener.label()
+	}
+	c.dlq.push(DeadEvent{
+		Signal:   event.signal,
+		Severity: event.severity,
+		Fields:   event.FieldsSorted(),
+		Reason:   reason,
+		Err:      err,
+		Time:     c.clock(),
+		Listener: label,
+	})
+}
+
+// DeadLetters returns the dead letter queue's contents, oldest first, without
+// removing them. Returns nil unless configured with WithDeadLetter.
+func (c *Capitan) DeadLetters() []DeadEvent {
+	if c.dlq == nil {
+		return nil
+	}
+	return c.dlq.snapshot(false)
+}
+
+// DrainDeadLetters returns and removes the dead letter queue's contents, oldest first.
+func (c *Capitan) DrainDeadLetters() []DeadEvent {
+	if c.dlq == nil {
+		return nil
+	}
+	return c.dlq.snapshot(true)
+}
+
+// RequeueDeadLetters drains the dead letter queue and re-emits each event with
+// its original signal, severity, and fields, returning the number re-emitted.
+// Requeued events go to all of the signal's listeners; those that fail again
+// return to the queue. Stops early if ctx is canceled, keeping the rest queued.
+func (c *Capitan) RequeueDeadLetters(ctx context.Context) int {
+	if c.dlq == nil {
+		return 0
+	}
+	dead := c.dlq.snapshot(true)
+	for i, d := range dead {
+		if ctx.Err() != nil {
+			for _, rest := range dead[i:] {
+				c.dlq.push(rest)
+			}
+			return i
+		}
+		c.EmitSeverity(ctx, d.Signal, d.Severity, d.Fields...)
+	}
+	return len(dead)
+}
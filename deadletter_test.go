@@ -0,0 +1,114 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDeadLetterQueue(t *testing.T) {
+	c := New(WithSyncMode(), WithDeadLetter(10))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.dlq", "Test dead letter signal")
+	key := NewStringKey("id")
+
+	failing := true
+	var delivered []string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		id, _ := key.From(e)
+		if failing && id == "panics" {
+			panic("boom")
+		}
+		delivered = append(delivered, id)
+	})
+
+	// Drop: context canceled before processing
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Emit(canceled, sig, key.Field("dropped"))
+
+	// Panic in listener
+	c.Warn(context.Background(), sig, key.Field("panics"))
+
+	dead := c.DeadLetters()
+	if len(dead) != 2 {
+		t.Fatalf("expected 2 dead letters, got %d", len(dead))
+	}
+	if dead[0].Reason != DropReasonCanceled || dead[0].Err != nil {
+		t.Errorf("expected canceled drop first, got %+v", dead[0])
+	}
+	if dead[1].Reason != DropReasonPanic || dead[1].Err == nil || dead[1].Severity != SeverityWarn {
+		t.Errorf("expected Warn panic second, got %+v", dead[1])
+	}
+	if dead[1].Signal != sig || len(dead[1].Fields) != 1 || dead[1].Fields[0].Value() != "panics" {
+		t.Errorf("expected signal and fields preserved, got %+v", dead[1])
+	}
+	if len(c.DeadLetters()) != 2 {
+		t.Error("DeadLetters should not remove entries")
+	}
+
+	// Requeue with a live context and a fixed listener
+	failing = false
+	delivered = nil
+	if n := c.RequeueDeadLetters(context.Background()); n != 2 {
+		t.Errorf("expected 2 requeued, got %d", n)
+	}
+	if len(delivered) != 2 || delivered[0] != "dropped" || delivered[1] != "panics" {
+		t.Errorf("expected both events redelivered in order, got %v", delivered)
+	}
+	if len(c.DeadLetters()) != 0 {
+		t.Errorf("expected empty queue after requeue, got %v", c.DeadLetters())
+	}
+}
+
+func TestDeadLetterListenerError(t *testing.T) {
+	c := New(WithSyncMode(), WithDeadLetter(10))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.dlq.error", "Test dead letter error signal")
+	testErr := errors.New("write failed")
+	c.HookE(sig, func(_ context.Context, _ *Event) error { return testErr })
+
+	c.Emit(context.Background(), sig)
+
+	dead := c.DrainDeadLetters()
+	if len(dead) != 1 || dead[0].Reason != DropReasonListenerError || !errors.Is(dead[0].Err, testErr) {
+		t.Errorf("expected listener error dead letter, got %+v", dead)
+	}
+	if len(c.DeadLetters()) != 0 {
+		t.Error("DrainDeadLetters should empty the queue")
+	}
+}
+
+func TestDeadLetterEvictsOldest(t *testing.T) {
+	c := New(WithSyncMode(), WithDeadLetter(2), WithMaxFields(0), WithMaxBytesFieldSize(1))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.dlq.evict", "Test dead letter eviction signal")
+	key := NewBytesKey("data")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+
+	for _, n := range []int{2, 3, 4} {
+		c.Emit(context.Background(), sig, key.Field(make([]byte, n)))
+	}
+
+	dead := c.DeadLetters()
+	if len(dead) != 2 {
+		t.Fatalf("expected capacity 2, got %d", len(dead))
+	}
+	for i, want := range []int{3, 4} {
+		if got := len(dead[i].Fields[0].Value().([]byte)); got != want || dead[i].Reason != DropReasonLimit {
+			t.Errorf("entry %d: expected %d-byte limit drop, got %d bytes (%s)", i, want, got, dead[i].Reason)
+		}
+	}
+}
+
+func TestDeadLetterDisabled(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	if c.DeadLetters() != nil || c.DrainDeadLetters() != nil || c.RequeueDeadLetters(context.Background()) != 0 {
+		t.Error("expected no dead letters without WithDeadLetter")
+	}
+}
@@ -0,0 +1,60 @@
+package capitan
+
+// WithDefaultFields adds fields to every event the instance creates, such as
+// the service name, environment, and host. A field passed to Emit with the
+// same name overrides the default for that event. Defaults are merged when
+// the event is built, after pre-emit hooks, computed fields, and field limits
+// have seen the emitted fields, so they don't count toward WithMaxFields.
+// They do appear in Stats.FieldSchemas. Later defaults replace earlier ones
+// with the same name; nil fields are ignored.
+func WithDefaultFields(fields ...Field) Option {
+	return func(c *Capitan) {
+		for _, f := range fields {
+			c.AddDefaultField(f)
+		}
+	}
+}
+
+// AddDefaultField adds a default field on the default instance.
+func AddDefaultField(f Field) {
+	defaultInstance().AddDefaultField(f)
+}
+
+// AddDefaultField adds f to every event created from now on, replacing any
+// default with the same name, as WithDefaultFields does at construction. Safe
+// to call while events are being emitted; events already built keep the
+// defaults they had. A nil field is ignored.
+func (c *Capitan) AddDefaultField(f Field) {
+	if f == nil || f.Key() == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var defaults []Field
+	if current := c.defaultFields.Load(); current != nil {
+		defaults = make([]Field, 0, len(*current)+1)
+		for _, existing := range *current {
+			if existing.Key().Name() != f.Key().Name() {
+				defaults = append(defaults, existing)
+			}
+		}
+	}
+	defaults = append(defaults, f)
+	c.defaultFields.Store(&defaults)
+}
+
+// applyDefaults adds the instance's default fields to e, keeping any field
+// the event already has with the same name.
+func (c *Capitan) applyDefaults(e *Event) {
+	defaults := c.defaultFields.Load()
+	if defaults == nil {
+		return
+	}
+	for _, f := range *defaults {
+		name := f.Key().Name()
+		if _, ok := e.fields[name]; !ok {
+			e.fields[name] = f
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithDefaultFields(t *testing.T) {
+	service := NewStringKey("service")
+	env := NewStringKey("env")
+	orderID := NewStringKey("order_id")
+
+	c := New(WithSyncMode(), WithDefaultFields(service.Field("orders"), env.Field("prod")))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.defaults", "Test default fields signal")
+	var got map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.FieldsMap() })
+
+	c.Emit(context.Background(), sig, orderID.Field("A1"))
+	if len(got) != 3 || got["service"] != "orders" || got["env"] != "prod" || got["order_id"] != "A1" {
+		t.Errorf("expected defaults merged with emitted fields, got %v", got)
+	}
+
+	// Emitted fields override same-named defaults
+	c.Emit(context.Background(), sig, env.Field("staging"))
+	if got["env"] != "staging" || got["service"] != "orders" {
+		t.Errorf("expected emitted env to override default, got %v", got)
+	}
+
+	// Defaults apply even to events emitted without fields
+	c.Emit(context.Background(), sig)
+	if len(got) != 2 {
+		t.Errorf("expected only defaults, got %v", got)
+	}
+}
+
+func TestAddDefaultField(t *testing.T) {
+	env := NewStringKey("env")
+	host := NewStringKey("host")
+
+	c := New(WithSyncMode(), WithDefaultFields(env.Field("prod")))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.defaults.add", "Test add default field signal")
+	var got map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.FieldsMap() })
+
+	c.AddDefaultField(host.Field("web-1"))
+	c.AddDefaultField(env.Field("canary"))
+	c.AddDefaultField(nil)
+	c.Emit(context.Background(), sig)
+
+	if len(got) != 2 || got["host"] != "web-1" || got["env"] != "canary" {
+		t.Errorf("expected added default and replaced env, got %v", got)
+	}
+}
+
+func TestDefaultFieldsSchema(t *testing.T) {
+	service := NewStringKey("service")
+	orderID := NewStringKey("order_id")
+
+	c := New(WithSyncMode(), WithDefaultFields(service.Field("orders")), WithMaxFields(1))
+	defer c.Shutdown()
+
+	withFields := NewSignal("test.defaults.schema", "Test default fields schema signal")
+	bare := NewSignal("test.defaults.schema.bare", "Test default fields bare schema signal")
+	c.Hook(withFields, func(_ context.Context, _ *Event) {})
+	c.Hook(bare, func(_ context.Context, _ *Event) {})
+
+	// The default doesn't count toward the one-field limit
+	if err := c.EmitChecked(context.Background(), withFields, orderID.Field("A1")); err != nil {
+		t.Fatalf("expected defaults excluded from field limits, got %v", err)
+	}
+	c.Emit(context.Background(), bare)
+
+	schemas := c.Stats().FieldSchemas
+	if keys := schemas[withFields]; len(keys) != 2 || keys[0].Name() != "order_id" || keys[1].Name() != "service" {
+		t.Errorf("expected schema with emitted and default fields, got %v", keys)
+	}
+	if keys := schemas[bare]; len(keys) != 1 || keys[0].Name() != "service" {
+		t.Errorf("expected schema of defaults for fieldless emit, got %v", keys)
+	}
+}
+
+func TestDefaultFieldsNoExtraAllocs(t *testing.T) {
+	sig := NewSignal("test.defaults.allocs", "Test default fields allocation signal")
+	orderID := NewStringKey("order_id")
+	field := orderID.Field("A1")
+
+	measure := func(opts ...Option) float64 {
+		c := New(append(opts, WithSyncMode())...)
+		defer c.Shutdown()
+		c.Hook(sig, func(_ context.Context, _ *Event) {})
+		ctx := context.Background()
+		return testing.AllocsPerRun(100, func() {
+			c.Emit(ctx, sig, field)
+		})
+	}
+
+	without := measure()
+	with := measure(WithDefaultFields(
+		NewStringKey("service").Field("orders"),
+		NewStringKey("env").Field("prod"),
+		NewStringKey("host").Field("web-1"),
+	))
+	if with > without {
+		t.Errorf("expected no extra allocations from defaults, got %.1f vs %.1f", with, without)
+	}
+}
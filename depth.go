@@ -0,0 +1,54 @@
+package capitan
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+// depthBuckets is the number of power-of-two buckets in a depthHistogram,
+// enough for any int depth.
+const depthBuckets = bits.UintSize + 1
+
+// depthHistogram records queue depth samples in power-of-two buckets:
+// bucket 0 holds depth 0, and bucket i depths 2^(i-1) through 2^i-1.
+// All updates are atomic, so workers record samples without locking.
+type depthHistogram struct {
+	counts [depthBuckets]atomic.Uint64
+	max    atomic.Int64
+}
+
+// observe records one depth sample.
+func (h *depthHistogram) observe(depth int) {
+	h.counts[bits.Len(uint(depth))].Add(1)
+	for {
+		high := h.max.Load()
+		if int64(depth) <= high || h.max.CompareAndSwap(high, int64(depth)) {
+			return
+		}
+	}
+}
+
+// p95 estimates the 95th percentile depth as the upper bound of the bucket
+// holding it, capped at the maximum sample. Returns 0 with no samples.
+func (h *depthHistogram) p95() int {
+	var counts [depthBuckets]uint64
+	var total uint64
+	for i := range h.counts {
+		counts[i] = h.counts[i].Load()
+		total += counts[i]
+	}
+	if total == 0 {
+		return 0
+	}
+
+	target := (total*95 + 99) / 100
+	var seen uint64
+	for i, n := range counts {
+		seen += n
+		if seen >= target {
+			upper := int64(1)<<i - 1
+			return int(min(upper, h.max.Load()))
+		}
+	}
+	return int(h.max.Load())
+}
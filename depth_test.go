@@ -0,0 +1,79 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDepthHistogram(t *testing.T) {
+	var h depthHistogram
+	if h.p95() != 0 {
+		t.Errorf("expected 0 with no samples, got %d", h.p95())
+	}
+
+	for i := 0; i < 95; i++ {
+		h.observe(0)
+	}
+	for i := 0; i < 5; i++ {
+		h.observe(40)
+	}
+	if got := h.p95(); got != 0 {
+		t.Errorf("expected p95 of 0 when 95%% of samples are empty, got %d", got)
+	}
+
+	h.observe(40)
+	if got := h.p95(); got != 40 {
+		t.Errorf("expected bucket bound capped at max 40, got %d", got)
+	}
+	h.observe(100)
+	if got := h.p95(); got != 63 {
+		t.Errorf("expected bucket bound 63, got %d", got)
+	}
+	if got := h.max.Load(); got != 100 {
+		t.Errorf("expected max 100, got %d", got)
+	}
+}
+
+func TestWithQueueDepthTracking(t *testing.T) {
+	c := New(WithQueueDepthTracking(), WithBufferSize(32))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.queue.depth.tracking", "Test queue depth tracking signal")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	first := true
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		if first {
+			first = false
+			close(started)
+			<-release
+		}
+	})
+
+	c.Emit(context.Background(), sig)
+	<-started
+	for i := 0; i < 20; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	close(release)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	stats := c.Stats()
+	if got := stats.MaxQueueDepth[sig]; got != 19 {
+		t.Errorf("expected max depth 19 after taking the first queued event, got %d", got)
+	}
+	if got := stats.P95QueueDepth[sig]; got < 15 || got > 19 {
+		t.Errorf("expected p95 depth near the burst, got %d", got)
+	}
+}
+
+func TestQueueDepthTrackingDisabled(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	if stats := c.Stats(); stats.MaxQueueDepth != nil || stats.P95QueueDepth != nil {
+		t.Error("expected depth tracking maps unset by default")
+	}
+}
@@ -0,0 +1,66 @@
+package capitan
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// FieldDiff describes one field that differs between two events.
+// Old is nil if the field was added, New is nil if it was removed.
+type FieldDiff struct {
+	Name string
+	Old  Field
+	New  Field
+}
+
+// DiffFields compares the fields of a and b by name and returns the ones
+// added, removed, or changed, sorted by name. Values are compared according to
+// their variant: byte slices with bytes.Equal, times with time.Time.Equal,
+// errors by message, and everything else with reflect.DeepEqual. Fields with
+// the same name but different variants are reported as changed.
+func DiffFields(a, b *Event) []FieldDiff {
+	var diffs []FieldDiff
+	for name, old := range a.fields {
+		if updated, ok := b.fields[name]; !ok || !fieldsEqual(old, updated) {
+			diffs = append(diffs, FieldDiff{Name: name, Old: old, New: b.fields[name]})
+		}
+	}
+	for name, added := range b.fields {
+		if _, ok := a.fields[name]; !ok {
+			diffs = append(diffs, FieldDiff{Name: name, New: added})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+	return diffs
+}
+
+// fieldsEqual reports whether two fields hold the same typed value.
+func fieldsEqual(a, b Field) bool {
+	if a.Variant() != b.Variant() {
+		return false
+	}
+	av, bv := a.Value(), b.Value()
+	switch a.Variant() {
+	case VariantBytes:
+		ab, aok := av.([]byte)
+		bb, bok := bv.([]byte)
+		if aok && bok {
+			return bytes.Equal(ab, bb)
+		}
+	case VariantTime:
+		at, aok := av.(time.Time)
+		bt, bok := bv.(time.Time)
+		if aok && bok {
+			return at.Equal(bt)
+		}
+	case VariantError:
+		ae, aok := av.(error)
+		be, bok := bv.(error)
+		if aok && bok {
+			return ae.Error() == be.Error()
+		}
+	}
+	return reflect.DeepEqual(av, bv)
+}
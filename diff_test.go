@@ -0,0 +1,65 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDiffFields(t *testing.T) {
+	sig := NewSignal("test.diff", "Test diff signal")
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	status := NewStringKey("status")
+	payload := NewBytesKey("payload")
+	updated := NewTimeKey("updated")
+	failure := NewErrorKey("failure")
+	note := NewStringKey("note")
+	total := NewIntKey("total")
+	count := NewKey[float64]("count", VariantFloat64)
+
+	before := newEvent(context.Background(), sig, SeverityInfo, ts,
+		status.Field("pending"),
+		payload.Field([]byte{1, 2, 3}),
+		updated.Field(ts),
+		failure.Field(errors.New("timeout")),
+		note.Field("removed later"),
+		NewIntKey("count").Field(1),
+	)
+	defer eventPool.Put(before)
+	after := newEvent(context.Background(), sig, SeverityInfo, ts,
+		status.Field("paid"),
+		payload.Field([]byte{1, 2, 3}),
+		updated.Field(ts.In(time.FixedZone("EST", -5*3600))),
+		failure.Field(errors.New("timeout")),
+		total.Field(10),
+		count.Field(1),
+	)
+	defer eventPool.Put(after)
+
+	diffs := DiffFields(before, after)
+
+	want := []string{"count", "note", "status", "total"}
+	if len(diffs) != len(want) {
+		t.Fatalf("expected diffs %v, got %+v", want, diffs)
+	}
+	for i, name := range want {
+		if diffs[i].Name != name {
+			t.Errorf("expected diff %d to be %s, got %s", i, name, diffs[i].Name)
+		}
+	}
+	if diffs[1].New != nil || diffs[1].Old.Value() != "removed later" {
+		t.Errorf("expected removed note, got %+v", diffs[1])
+	}
+	if diffs[2].Old.Value() != "pending" || diffs[2].New.Value() != "paid" {
+		t.Errorf("expected status change, got %+v", diffs[2])
+	}
+	if diffs[3].Old != nil || diffs[3].New.Value() != 10 {
+		t.Errorf("expected added total, got %+v", diffs[3])
+	}
+
+	if got := DiffFields(before, before); len(got) != 0 {
+		t.Errorf("expected no diffs for identical events, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package capitan
+
+import "context"
+
+// EnricherSignal is the signal passed to the panic handler, and counted in
+// panic metrics, when an enricher registered with WithEnricher panics.
+// No event is emitted on it.
+var EnricherSignal = NewSignal("capitan.enricher", "An enricher panicked while an event was being emitted")
+
+// Enricher derives fields from an emit's context, such as a tenant or trace ID.
+type Enricher func(ctx context.Context) []Field
+
+// WithEnricher registers fn to run on the emitting goroutine for every emit,
+// before pre-emit hooks and field limits. Its fields are added to the event
+// beneath the emitted ones: a field passed to Emit overrides an enriched
+// field with the same name. Enrichers run in registration order, and a later
+// enricher's field overrides an earlier one's. A panicking enricher
+// contributes no fields; the panic is recovered and reported to the panic
+// handler with EnricherSignal, and the emit continues.
+func WithEnricher(fn Enricher) Option {
+	return func(c *Capitan) {
+		if fn == nil {
+			c.invalid("WithEnricher: enricher is nil")
+			return
+		}
+		c.enrichers = append(c.enrichers, fn)
+	}
+}
+
+// enrich merges the enrichers' fields beneath fields. Returns fields as is
+// when no enricher adds anything.
+func (c *Capitan) enrich(ctx context.Context, fields []Field) []Field {
+	if len(c.enrichers) == 0 {
+		return fields
+	}
+
+	var enriched []Field
+	for _, fn := range c.enrichers {
+		for _, f := range c.runEnricher(ctx, fn) {
+			if f == nil || f.Key() == nil || hasFieldNamed(fields, f.Key().Name()) {
+				continue
+			}
+			// A later enricher replaces an earlier one's field
+			enriched = replaceFieldNamed(enriched, f)
+		}
+	}
+	if len(enriched) == 0 {
+		return fields
+	}
+	return append(enriched, fields...)
+}
+
+// runEnricher calls fn, recovering and reporting a panic.
+func (c *Capitan) runEnricher(ctx context.Context, fn Enricher) (fields []Field) {
+	defer func() {
+		if r := recover(); r != nil {
+			fields = nil
+			c.recordPanic(EnricherSignal)
+			c.mu.RLock()
+			handler := c.panicHandler
+			c.mu.RUnlock()
+			if handler != nil {
+				handler(EnricherSignal, r)
+			}
+		}
+	}()
+	return fn(ctx)
+}
+
+// hasFieldNamed reports whether fields contains a field with the given name.
+func hasFieldNamed(fields []Field, name string) bool {
+	for _, f := range fields {
+		if f != nil && f.Key() != nil && f.Key().Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceFieldNamed replaces the field in fields with f's name, or appends f.
+func replaceFieldNamed(fields []Field, f Field) []Field {
+	for i, existing := range fields {
+		if existing.Key().Name() == f.Key().Name() {
+			fields[i] = f
+			return fields
+		}
+	}
+	return append(fields, f)
+}
@@ -0,0 +1,134 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type tenantCtxKey struct{}
+
+func TestWithEnricher(t *testing.T) {
+	tenant := NewStringKey("tenant")
+	orderID := NewStringKey("order_id")
+
+	fromCtx := func(ctx context.Context) []Field {
+		if id, ok := ctx.Value(tenantCtxKey{}).(string); ok {
+			return []Field{tenant.Field(id)}
+		}
+		return nil
+	}
+
+	c := New(WithEnricher(fromCtx))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.enricher", "Test enricher signal")
+	got := make(chan map[string]any, 1)
+	c.Hook(sig, func(_ context.Context, e *Event) { got <- e.FieldsMap() })
+
+	ctx := context.WithValue(context.Background(), tenantCtxKey{}, "acme")
+	c.Emit(ctx, sig, orderID.Field("A1"))
+	if fields := <-got; len(fields) != 2 || fields["tenant"] != "acme" || fields["order_id"] != "A1" {
+		t.Errorf("expected tenant from context merged with emitted fields, got %v", fields)
+	}
+
+	// Emitted fields override enriched ones
+	c.Emit(ctx, sig, tenant.Field("explicit"))
+	if fields := <-got; len(fields) != 1 || fields["tenant"] != "explicit" {
+		t.Errorf("expected emitted tenant to win, got %v", fields)
+	}
+
+	// Nothing is added when the enricher returns no fields
+	c.Emit(context.Background(), sig)
+	if fields := <-got; len(fields) != 0 {
+		t.Errorf("expected no fields, got %v", fields)
+	}
+}
+
+func TestWithEnricherOrder(t *testing.T) {
+	source := NewStringKey("source")
+	first := NewStringKey("first")
+
+	c := New(
+		WithSyncMode(),
+		WithEnricher(func(context.Context) []Field {
+			return []Field{first.Field("yes"), source.Field("first")}
+		}),
+		WithEnricher(func(context.Context) []Field {
+			return []Field{source.Field("second")}
+		}),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.enricher.order", "Test enricher order signal")
+	var got map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.FieldsMap() })
+
+	c.Emit(context.Background(), sig)
+	if len(got) != 2 || got["first"] != "yes" || got["source"] != "second" {
+		t.Errorf("expected later enricher to override earlier, got %v", got)
+	}
+}
+
+func TestWithEnricherPanic(t *testing.T) {
+	env := NewStringKey("env")
+
+	var panicked Signal
+	var recovered any
+	c := New(
+		WithSyncMode(),
+		WithPanicHandler(func(signal Signal, r any) {
+			panicked, recovered = signal, r
+		}),
+		WithEnricher(func(context.Context) []Field { panic("enricher boom") }),
+		WithEnricher(func(context.Context) []Field { return []Field{env.Field("prod")} }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.enricher.panic", "Test enricher panic signal")
+	var got map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.FieldsMap() })
+
+	c.Emit(context.Background(), sig)
+	if panicked != EnricherSignal || recovered != "enricher boom" {
+		t.Errorf("expected panic reported on EnricherSignal, got %v %v", panicked.Name(), recovered)
+	}
+	if len(got) != 1 || got["env"] != "prod" {
+		t.Errorf("expected emit to continue with remaining enrichers, got %v", got)
+	}
+	if n := c.metrics.Panics()[EnricherSignal]; n != 1 {
+		t.Errorf("expected 1 panic counted on EnricherSignal, got %d", n)
+	}
+}
+
+func TestWithEnricherBatch(t *testing.T) {
+	tenant := NewStringKey("tenant")
+	c := New(
+		WithSyncMode(),
+		WithEnricher(func(context.Context) []Field { return []Field{tenant.Field("acme")} }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.enricher.batch", "Test enricher batch signal")
+	var tenants []string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		if f, ok := e.Get(tenant).(GenericField[string]); ok {
+			tenants = append(tenants, f.Value().(string))
+		}
+	})
+
+	batch := [][]Field{nil, {tenant.Field("other")}}
+	c.EmitBatch(context.Background(), sig, batch)
+	if len(tenants) != 2 || tenants[0] != "acme" || tenants[1] != "other" {
+		t.Errorf("expected enriched batch events, got %v", tenants)
+	}
+	if batch[0] != nil {
+		t.Errorf("expected caller's field sets left untouched, got %v", batch[0])
+	}
+}
+
+func TestWithEnricherNil(t *testing.T) {
+	if _, err := NewValidated(WithEnricher(nil)); !errors.Is(err, ErrInvalidOption) {
+		t.Error("expected nil enricher to be reported as invalid")
+	}
+}
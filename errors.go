@@ -0,0 +1,42 @@
+package capitan
+
+import "errors"
+
+// ErrListenerExists is returned by HookExclusive when the signal already has a listener.
+var ErrListenerExists = errors.New("capitan: signal already has a listener")
+
+// ErrTooManyFields is reported when an emitted event exceeds the WithMaxFields limit.
+var ErrTooManyFields = errors.New("capitan: event has too many fields")
+
+// ErrFieldsTooLarge is reported when an emitted event's []byte fields exceed
+// the WithMaxBytesFieldSize limit.
+var ErrFieldsTooLarge = errors.New("capitan: event byte fields too large")
+
+// ErrNotStruct is returned by EmitStruct when the value is not a struct or pointer to struct.
+var ErrNotStruct = errors.New("capitan: value is not a struct")
+
+// ErrUnsupportedField is returned by EmitStruct in strict mode when a struct
+// field's type has no built-in variant.
+var ErrUnsupportedField = errors.New("capitan: unsupported struct field type")
+
+// ErrEmitLoop is reported when an emit exceeds the WithMaxEmitDepth limit.
+var ErrEmitLoop = errors.New("capitan: emit loop detected")
+
+// ErrDuplicateField is reported under DupError when an event has several
+// fields with the same name.
+var ErrDuplicateField = errors.New("capitan: duplicate field name")
+
+// ErrInvalidURL is returned by URLKey.Parse when the value is not an absolute URL.
+var ErrInvalidURL = errors.New("capitan: invalid URL")
+
+// ErrInvalidUUID is returned by ParseUUID when the value is not a UUID in
+// canonical 8-4-4-4-12 hex form.
+var ErrInvalidUUID = errors.New("capitan: invalid UUID")
+
+// ErrInvalidOption is returned by NewValidated for each option with an
+// invalid value or that conflicts with another option.
+var ErrInvalidOption = errors.New("capitan: invalid option")
+
+// ErrShutdown is returned by calls that wait for events when the instance
+// shuts down before they complete.
+var ErrShutdown = errors.New("capitan: instance shut down")
@@ -0,0 +1,102 @@
+package capitan
+
+import (
+	"encoding/json"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// VariantErrorTrace is the variant of fields created by an ErrorTraceKey.
+const VariantErrorTrace Variant = "capitan.TracedError"
+
+// maxTraceDepth caps how many frames a TracedError records.
+const maxTraceDepth = 32
+
+// skipErrorTraces disables stack capture for ErrorTraceKey fields.
+var skipErrorTraces atomic.Bool
+
+// SetErrorTraceCapture enables or disables stack capture for ErrorTraceKey
+// fields process-wide. Enabled by default. When disabled, fields still carry
+// the error but Stack returns an empty string, avoiding the cost of walking
+// the stack on performance-sensitive paths.
+func SetErrorTraceCapture(enabled bool) {
+	skipErrorTraces.Store(!enabled)
+}
+
+// TracedError is an error paired with the stack of the code that created its
+// field. It implements error and unwraps to the original error.
+type TracedError struct {
+	err   error
+	stack string
+}
+
+// Err returns the original error.
+func (t TracedError) Err() error { return t.err }
+
+// Stack returns the captured stack, one "function\n\tfile:line" entry per
+// frame, innermost first. Empty if capture was disabled.
+func (t TracedError) Stack() string { return t.stack }
+
+// Error returns the original error's message, or "<nil>".
+func (t TracedError) Error() string {
+	if t.err == nil {
+		return "<nil>"
+	}
+	return t.err.Error()
+}
+
+// Unwrap returns the original error, for errors.Is and errors.As.
+func (t TracedError) Unwrap() error { return t.err }
+
+// MarshalJSON renders the error as {"error": message, "stack": stack}.
+func (t TracedError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Error string `json:"error"`
+		Stack string `json:"stack,omitempty"`
+	}{t.Error(), t.stack})
+}
+
+// ErrorTraceKey is a key for errors that carry the stack of their emit site.
+// From returns the TracedError.
+type ErrorTraceKey struct {
+	GenericKey[TracedError]
+}
+
+// NewErrorTraceKey creates an ErrorTraceKey with the given name.
+func NewErrorTraceKey(name string) ErrorTraceKey {
+	return ErrorTraceKey{NewKey[TracedError](name, VariantErrorTrace)}
+}
+
+// Field wraps err in a TracedError, capturing the caller's stack unless
+// disabled with SetErrorTraceCapture.
+func (k ErrorTraceKey) Field(err error) Field {
+	traced := TracedError{err: err}
+	if !skipErrorTraces.Load() {
+		traced.stack = captureStack(3)
+	}
+	return k.GenericKey.Field(traced)
+}
+
+// captureStack formats the stack starting skip frames above runtime.Callers.
+func captureStack(skip int) string {
+	pcs := make([]uintptr, maxTraceDepth)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(frame.Line))
+		b.WriteByte('\n')
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
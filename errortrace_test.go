@@ -0,0 +1,70 @@
+package capitan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestErrorTraceKey(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.error.trace", "Test error trace signal")
+	key := NewErrorTraceKey("cause")
+	errBoom := errors.New("boom")
+
+	var traced TracedError
+	var found bool
+	var rendered []byte
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		traced, found = key.From(e)
+		var err error
+		if rendered, err = json.Marshal(e); err != nil {
+			t.Errorf("marshal failed: %v", err)
+		}
+	})
+	c.Error(context.Background(), sig, key.Field(errBoom))
+
+	if !found {
+		t.Fatal("expected traced error field")
+	}
+	if !errors.Is(traced, errBoom) || traced.Err() != errBoom || traced.Error() != "boom" {
+		t.Errorf("expected traced error to wrap original, got %v", traced)
+	}
+	if !strings.Contains(traced.Stack(), "capitan.TestErrorTraceKey") {
+		t.Errorf("expected stack to reference the emitting test, got:\n%s", traced.Stack())
+	}
+	if strings.Contains(traced.Stack(), "ErrorTraceKey.Field") {
+		t.Errorf("expected stack to start at the caller, got:\n%s", traced.Stack())
+	}
+
+	var decoded struct {
+		Fields map[string]struct {
+			Error string `json:"error"`
+			Stack string `json:"stack"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(rendered, &decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if decoded.Fields["cause"].Error != "boom" || decoded.Fields["cause"].Stack != traced.Stack() {
+		t.Errorf("expected JSON error and stack sub-keys, got %s", rendered)
+	}
+}
+
+func TestSetErrorTraceCapture(t *testing.T) {
+	SetErrorTraceCapture(false)
+	defer SetErrorTraceCapture(true)
+
+	f := NewErrorTraceKey("cause").Field(errors.New("boom"))
+	traced, ok := f.Value().(TracedError)
+	if !ok {
+		t.Fatalf("expected TracedError value, got %T", f.Value())
+	}
+	if traced.Stack() != "" || traced.Error() != "boom" {
+		t.Errorf("expected error without stack, got %q / %q", traced.Error(), traced.Stack())
+	}
+}
@@ -2,17 +2,29 @@ package capitan
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-var eventPool = sync.Pool{
-	New: func() any {
-		return &Event{
-			fields:   make(map[string]Field),
-			severity: SeverityInfo,
-		}
-	},
+// eventSequence is the process-wide counter used to order events across signals.
+var eventSequence atomic.Uint64
+
+// eventPool is the shared pool used by the default instance and newEvent.
+// Instances created with New get their own pool.
+var eventPool = newEventPool()
+
+// newEventPool creates a pool of reusable events.
+func newEventPool() *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			return &Event{
+				fields:   make(map[string]Field),
+				severity: SeverityInfo,
+			}
+		},
+	}
 }
 
 // Event represents a signal emission with typed fields.
@@ -32,6 +44,37 @@ type Event struct {
 
 	// severity indicates the logging severity level of this event.
 	severity Severity
+
+	// sequence is a process-wide monotonically increasing emission number.
+	sequence uint64
+
+	// callerFile and callerLine record the emit site when caller info is enabled.
+	callerFile string
+	callerLine int
+
+	// cause identifies the event that caused this one, set by EmitCaused.
+	cause *eventCause
+
+	// enqueuedAt and startedAt record when the event was queued for delivery
+	// and when delivery from the queue began; zero if it was never queued.
+	enqueuedAt time.Time
+	startedAt  time.Time
+
+	// attempt counts redeliveries of this event to a failed listener.
+	attempt int
+
+	// target restricts delivery to a single listener when retrying.
+	target *Listener
+
+	// redacted names fields masked when the event is rendered for output.
+	redacted map[string]struct{}
+
+	// results is the listener scratch area, set only by EmitWithResult.
+	results *eventResults
+
+	// duplicates holds every field for names emitted more than once under
+	// DupKeepAll. Never modified after creation.
+	duplicates map[string][]Field
 }
 
 // Signal returns the event's signal identifier.
@@ -55,33 +98,133 @@ func (e *Event) Severity() Severity {
 	return e.severity
 }
 
-// newEvent creates an Event with the given context, signal, severity and fields.
-// Events are pooled internally to reduce allocations.
+// Sequence returns the event's process-wide emission sequence number.
+// Sequences increase monotonically across all signals and instances, so they
+// order events even when timestamps collide.
+func (e *Event) Sequence() uint64 {
+	return e.sequence
+}
+
+// Caller returns the file and line of the code that emitted the event.
+// Only populated when the instance is configured with WithCallerInfo;
+// ok is false otherwise.
+func (e *Event) Caller() (file string, line int, ok bool) {
+	if e.callerFile == "" {
+		return "", 0, false
+	}
+	return e.callerFile, e.callerLine, true
+}
+
+// QueueLatency returns how long the event waited in a queue before its
+// listeners began processing it, measured from when the emitter began queueing
+// it, so time blocked on a full queue is included. Unlike the time since
+// Timestamp, it excludes emit-time work and isolates backpressure delay.
+// For a HookBuffered listener it covers the listener's own queue.
+// Returns 0 for events delivered without queueing, as in sync mode.
+func (e *Event) QueueLatency() time.Duration {
+	if e.enqueuedAt.IsZero() || e.startedAt.IsZero() {
+		return 0
+	}
+	return e.startedAt.Sub(e.enqueuedAt)
+}
+
+// Attempt returns the delivery attempt number, starting at 1.
+// Events redelivered after a listener error carry an incremented attempt.
+func (e *Event) Attempt() int {
+	return e.attempt + 1
+}
+
+// newEvent creates an Event from the shared pool.
 func newEvent(ctx context.Context, signal Signal, severity Severity, timestamp time.Time, fields ...Field) *Event {
-	e := eventPool.Get().(*Event) //nolint:errcheck // Pool always returns *Event
+	return newPooledEvent(ctx, eventPool, signal, severity, timestamp, fields...)
+}
+
+// newEvent creates an Event from the instance's pool.
+func (c *Capitan) newEvent(ctx context.Context, signal Signal, severity Severity, timestamp time.Time, fields ...Field) *Event {
+	e := newPooledEvent(ctx, c.pool, signal, severity, timestamp, fields...)
+	e.redacted = c.redacted
+	if c.dupPolicy == DupKeepAll && len(e.fields) < len(fields) {
+		e.duplicates = collectDuplicates(fields)
+	}
+	c.applyDefaults(e)
+	return e
+}
+
+// newPooledEvent creates an Event with the given context, signal, severity and fields.
+// Events are pooled to reduce allocations and must be returned to the same pool.
+func newPooledEvent(ctx context.Context, pool *sync.Pool, signal Signal, severity Severity, timestamp time.Time, fields ...Field) *Event {
+	e := pool.Get().(*Event) //nolint:errcheck // Pool always returns *Event
 	e.signal = signal
 	e.timestamp = timestamp
 	e.ctx = ctx
 	e.severity = severity
+	e.sequence = eventSequence.Add(1)
+	e.callerFile = ""
+	e.callerLine = 0
+	e.cause = nil
+	e.enqueuedAt = time.Time{}
+	e.startedAt = time.Time{}
+	e.attempt = 0
+	e.target = nil
+	e.redacted = nil
+	e.results = nil
+	e.duplicates = nil
 
 	// Clear existing fields
 	for k := range e.fields {
 		delete(e.fields, k)
 	}
 
-	// Add new fields, keyed by name
+	// Add new fields, keyed by name, skipping nil fields and keys
 	for _, field := range fields {
+		if field == nil || field.Key() == nil {
+			continue
+		}
 		e.fields[field.Key().Name()] = field
 	}
 
 	return e
 }
 
-// Get retrieves a field by key, returning nil if not found.
+// clone copies the event, including its fields, into a new event from pool.
+func (e *Event) clone(pool *sync.Pool) *Event {
+	c := pool.Get().(*Event) //nolint:errcheck // Pool always returns *Event
+	fields := c.fields
+	*c = *e
+	c.fields = fields
+
+	for k := range c.fields {
+		delete(c.fields, k)
+	}
+	for k, v := range e.fields {
+		c.fields[k] = v
+	}
+	return c
+}
+
+// Get retrieves a field by key, returning nil if not found or if key is nil.
 func (e Event) Get(key Key) Field {
+	if key == nil {
+		return nil
+	}
 	return e.fields[key.Name()]
 }
 
+// GetAll returns every field emitted with the given name, in emit order.
+// Only events from an instance using DupKeepAll retain more than one;
+// otherwise it returns the single field, or nil if there is none.
+func (e *Event) GetAll(name string) []Field {
+	if all, ok := e.duplicates[name]; ok {
+		result := make([]Field, len(all))
+		copy(result, all)
+		return result
+	}
+	if f, ok := e.fields[name]; ok {
+		return []Field{f}
+	}
+	return nil
+}
+
 // Fields returns all fields as a slice.
 // Returns a defensive copy; modifications don't affect the event.
 func (e Event) Fields() []Field {
@@ -91,3 +234,28 @@ func (e Event) Fields() []Field {
 	}
 	return result
 }
+
+// FieldsSorted returns all fields sorted by key name, for deterministic
+// display and serialization. Returns a defensive copy like Fields.
+func (e *Event) FieldsSorted() []Field {
+	result := e.Fields()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key().Name() < result[j].Key().Name()
+	})
+	return result
+}
+
+// FieldsMap returns the event's fields as a map of field name to Value().
+// Returns a defensive copy; modifications don't affect the event.
+func (e *Event) FieldsMap() map[string]any {
+	result := make(map[string]any, len(e.fields))
+	for name, field := range e.fields {
+		result[name] = field.Value()
+	}
+	return result
+}
+
+// ValuesMap is equivalent to FieldsMap.
+func (e *Event) ValuesMap() map[string]any {
+	return e.FieldsMap()
+}
@@ -2,6 +2,8 @@ package capitan
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -293,3 +295,225 @@ func TestSeverityMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestEventSequenceIncreasing(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.sequence", "Test sequence signal")
+	key := NewIntKey("value")
+
+	const numEvents = 1000
+	sequences := make([]uint64, 0, numEvents)
+
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		sequences = append(sequences, e.Sequence())
+	})
+
+	for i := 0; i < numEvents; i++ {
+		c.Emit(context.Background(), sig, key.Field(i))
+	}
+
+	if len(sequences) != numEvents {
+		t.Fatalf("expected %d events, got %d", numEvents, len(sequences))
+	}
+
+	for i := 1; i < len(sequences); i++ {
+		if sequences[i] <= sequences[i-1] {
+			t.Fatalf("sequence not strictly increasing at %d: %d <= %d", i, sequences[i], sequences[i-1])
+		}
+	}
+}
+
+func TestEventSequenceConcurrentUnique(t *testing.T) {
+	c := New()
+
+	sig := NewSignal("test.sequence.concurrent", "Test concurrent sequence signal")
+	key := NewIntKey("value")
+
+	const numGoroutines = 10
+	const numEvents = 100
+
+	var mu sync.Mutex
+	seen := make(map[uint64]struct{}, numGoroutines*numEvents)
+	duplicates := 0
+
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		mu.Lock()
+		if _, exists := seen[e.Sequence()]; exists {
+			duplicates++
+		}
+		seen[e.Sequence()] = struct{}{}
+		mu.Unlock()
+	})
+
+	var wg sync.WaitGroup
+	for g := 0; g < numGoroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < numEvents; i++ {
+				c.Emit(context.Background(), sig, key.Field(i))
+			}
+		}()
+	}
+	wg.Wait()
+	c.Shutdown()
+
+	if duplicates != 0 {
+		t.Errorf("expected no duplicate sequences, got %d", duplicates)
+	}
+	if len(seen) != numGoroutines*numEvents {
+		t.Errorf("expected %d unique sequences, got %d", numGoroutines*numEvents, len(seen))
+	}
+}
+
+func TestEventSequenceOverwrittenOnReuse(t *testing.T) {
+	sig := NewSignal("test.sequence.pool", "Test sequence pooling signal")
+
+	event1 := newEvent(context.Background(), sig, SeverityInfo, time.Now())
+	first := event1.Sequence()
+	eventPool.Put(event1)
+
+	event2 := newEvent(context.Background(), sig, SeverityInfo, time.Now())
+	if event2.Sequence() <= first {
+		t.Errorf("expected reused event sequence > %d, got %d", first, event2.Sequence())
+	}
+}
+
+// nilKeyField is a Field whose key is nil.
+type nilKeyField struct{}
+
+func (nilKeyField) Variant() Variant { return VariantString }
+func (nilKeyField) Key() Key         { return nil }
+func (nilKeyField) Value() any       { return "orphan" }
+
+func TestEmitSkipsNilFields(t *testing.T) {
+	var panicked any
+	c := New(WithSyncMode(), WithPanicHandler(func(_ Signal, r any) { panicked = r }))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.nil.fields", "Test nil fields signal")
+	key := NewStringKey("valid")
+
+	var fields []Field
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		fields = e.Fields()
+	})
+
+	c.Emit(context.Background(), sig, nil, key.Field("x"), nilKeyField{})
+
+	if panicked != nil {
+		t.Fatalf("unexpected panic: %v", panicked)
+	}
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+	if fields[0].Key().Name() != "valid" {
+		t.Errorf("expected valid field, got %q", fields[0].Key().Name())
+	}
+}
+
+func TestEventGetNilKey(t *testing.T) {
+	sig := NewSignal("test.nil.key", "Test nil key signal")
+	event := newEvent(context.Background(), sig, SeverityInfo, time.Now(), NewStringKey("a").Field("x"))
+
+	if field := event.Get(nil); field != nil {
+		t.Errorf("expected nil for nil key, got %v", field)
+	}
+}
+
+func TestEventFieldsMap(t *testing.T) {
+	sig := NewSignal("test.fields.map", "Test fields map signal")
+	strKey := NewStringKey("name")
+	intKey := NewIntKey("count")
+
+	event := newEvent(context.Background(), sig, SeverityInfo, time.Now(), strKey.Field("test"), intKey.Field(42))
+
+	m := event.FieldsMap()
+	if len(m) != 2 || m["name"] != "test" || m["count"] != 42 {
+		t.Fatalf("unexpected map: %v", m)
+	}
+
+	// Mutating the map must not affect the event
+	m["name"] = "changed"
+	delete(m, "count")
+
+	if v, _ := strKey.From(event); v != "test" {
+		t.Errorf("event mutated through map: name=%q", v)
+	}
+	if event.Get(intKey) == nil {
+		t.Error("event mutated through map: count removed")
+	}
+}
+
+func TestEventFieldsSorted(t *testing.T) {
+	sig := NewSignal("test.fields.sorted", "Test sorted fields signal")
+	event := newEvent(context.Background(), sig, SeverityInfo, time.Now(),
+		NewStringKey("zeta").Field("z"),
+		NewIntKey("alpha").Field(1),
+		NewBoolKey("mid").Field(true),
+	)
+	defer eventPool.Put(event)
+
+	for i := 0; i < 10; i++ {
+		fields := event.FieldsSorted()
+		if len(fields) != 3 {
+			t.Fatalf("expected 3 fields, got %d", len(fields))
+		}
+		names := []string{fields[0].Key().Name(), fields[1].Key().Name(), fields[2].Key().Name()}
+		if names[0] != "alpha" || names[1] != "mid" || names[2] != "zeta" {
+			t.Fatalf("expected sorted names, got %v", names)
+		}
+	}
+}
+
+func TestEventQueueLatency(t *testing.T) {
+	var now atomic.Int64
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return base.Add(time.Duration(now.Load())) }
+
+	c := New(WithClock(clock))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.queue.latency", "Test queue latency signal")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	latencies := make(chan time.Duration, 2)
+	var first atomic.Bool
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		latencies <- e.QueueLatency()
+		if first.CompareAndSwap(false, true) {
+			close(started)
+			<-release
+		}
+	})
+
+	// The first event occupies the worker while the second waits in the queue
+	c.Emit(context.Background(), sig)
+	<-started
+	c.Emit(context.Background(), sig)
+	now.Store(int64(50 * time.Millisecond))
+	close(release)
+
+	if got := <-latencies; got != 0 {
+		t.Errorf("expected no queue latency for the first event, got %v", got)
+	}
+	if got := <-latencies; got != 50*time.Millisecond {
+		t.Errorf("expected 50ms queue latency for the queued event, got %v", got)
+	}
+}
+
+func TestEventQueueLatencySync(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.queue.latency.sync", "Test sync queue latency signal")
+	latency := time.Duration(-1)
+	c.Hook(sig, func(_ context.Context, e *Event) { latency = e.QueueLatency() })
+
+	c.Emit(context.Background(), sig)
+	if latency != 0 {
+		t.Errorf("expected no queue latency in sync mode, got %v", latency)
+	}
+}
@@ -0,0 +1,57 @@
+package capitan
+
+import (
+	"context"
+	"iter"
+)
+
+// Events returns an iterator over snapshots of events on signal from the
+// default instance.
+func Events(ctx context.Context, signal Signal) iter.Seq[EventSnapshot] {
+	return defaultInstance().Events(ctx, signal)
+}
+
+// Events returns an iterator over snapshots of the events processed on
+// signal, for consumer goroutines written as
+//
+//	for e := range c.Events(ctx, sig) { ... }
+//
+// Each iteration hooks its own listener when it starts, so only events
+// delivered after that point are seen. The sequence ends when ctx is done,
+// the instance shuts down, or the loop breaks; the listener is closed in
+// every case.
+//
+// Snapshots wait in a channel sized like the instance's buffer. The listener
+// never blocks the signal's worker: when the consumer falls behind and the
+// channel is full, the event is dropped for this iterator and counted under
+// DropReasonOverflow.
+func (c *Capitan) Events(ctx context.Context, signal Signal) iter.Seq[EventSnapshot] {
+	return func(yield func(EventSnapshot) bool) {
+		c.mu.RLock()
+		size := c.bufferSize
+		c.mu.RUnlock()
+
+		snapshots := make(chan EventSnapshot, size)
+		listener := c.hookInternal(signal, func(_ context.Context, e *Event) {
+			select {
+			case snapshots <- e.Snapshot():
+			default:
+				c.reportDrop(e.signal, DropReasonOverflow)
+			}
+		})
+		defer listener.Close()
+
+		for {
+			select {
+			case snap := <-snapshots:
+				if !yield(snap) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			case <-c.shutdown:
+				return
+			}
+		}
+	}
+}
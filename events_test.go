@@ -0,0 +1,119 @@
+package capitan
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEvents(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("events.iter", "Test events iterator signal")
+	n := NewIntKey("n")
+
+	emitWhenHooked(c, sig,
+		[]Field{n.Field(1)},
+		[]Field{n.Field(2)},
+		[]Field{n.Field(3)},
+		[]Field{n.Field(4)},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var got []int
+	for e := range c.Events(ctx, sig) {
+		f, _ := e.Get(n).(GenericField[int])
+		got = append(got, f.Get())
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("expected events 1, 2, 3 in order, got %v", got)
+	}
+	if c.HasListeners(sig) {
+		t.Error("expected listener closed after break")
+	}
+}
+
+func TestEventsContextDone(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("events.ctx", "Test events context signal")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	count := 0
+	for range c.Events(ctx, sig) {
+		count++
+	}
+
+	if count != 0 {
+		t.Errorf("expected no events, got %d", count)
+	}
+	if c.HasListeners(sig) {
+		t.Error("expected listener closed when context is done")
+	}
+}
+
+func TestEventsOverflow(t *testing.T) {
+	var drops atomic.Int32
+	c := New(
+		WithSyncMode(),
+		WithBufferSize(1),
+		WithDropHandler(func(_ Signal, reason DropReason) {
+			if reason == DropReasonOverflow {
+				drops.Add(1)
+			}
+		}),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("events.overflow", "Test events overflow signal")
+	n := NewIntKey("n")
+
+	received := make(chan int)
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range c.Events(context.Background(), sig) {
+			f, _ := e.Get(n).(GenericField[int])
+			received <- f.Get()
+			if f.Get() != 1 {
+				return
+			}
+			<-release
+		}
+	}()
+
+	for !c.HasListeners(sig) {
+		time.Sleep(time.Millisecond)
+	}
+	c.Emit(context.Background(), sig, n.Field(1))
+	if v := <-received; v != 1 {
+		t.Fatalf("expected first event, got %d", v)
+	}
+
+	// The consumer is busy: one event fits in the channel, the rest drop
+	c.Emit(context.Background(), sig, n.Field(2))
+	c.Emit(context.Background(), sig, n.Field(3))
+	c.Emit(context.Background(), sig, n.Field(4))
+	close(release)
+
+	if v := <-received; v != 2 {
+		t.Errorf("expected buffered event 2, got %d", v)
+	}
+	<-done
+
+	if got := drops.Load(); got != 2 {
+		t.Errorf("expected 2 overflow drops, got %d", got)
+	}
+}
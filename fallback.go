@@ -0,0 +1,106 @@
+package capitan
+
+import (
+	"context"
+	"time"
+)
+
+// HookDefault registers a fallback callback on the default instance.
+func HookDefault(callback EventCallback) *Listener {
+	return defaultInstance().HookDefault(callback)
+}
+
+// HookDefault registers a fallback callback that receives events emitted to
+// signals with no listeners or observers, which are otherwise dropped before
+// the event is built. Signals kept with WithEventHistory but not yet hooked
+// still record their events for replay and also reach the fallback. Use it to catch misspelled signals or log unrouted
+// events. An instance has one fallback: hooking another closes the previous
+// one. Closing it restores dropping. In async mode the callback runs on its
+// own goroutine with a queue of the configured buffer size, overflowing like
+// HookBuffered; in sync mode it is invoked directly.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookDefault(callback EventCallback) *Listener {
+	listener := &Listener{
+		callback: callback,
+		capitan:  c,
+	}
+	if !c.syncMode {
+		listener.queue = &listenerQueue{
+			events: make(chan *Event, c.bufferSize),
+			done:   make(chan struct{}),
+		}
+		c.listenerWG.Add(1)
+		go c.deliverBuffered(listener)
+	}
+
+	c.mu.Lock()
+	// Closed instances never register listeners
+	if c.registry == nil {
+		c.mu.Unlock()
+		listener.release()
+		return listener
+	}
+	previous := c.fallback.Swap(listener)
+	if previous != nil {
+		previous.active.Store(false)
+	}
+	listener.active.Store(true)
+	c.mu.Unlock()
+
+	if previous != nil {
+		previous.release()
+	}
+	return listener
+}
+
+// routeFallback delivers an event for a signal without listeners to the
+// fallback listener, if one is hooked.
+func (c *Capitan) routeFallback(ctx context.Context, signal Signal, severity Severity, timestamp time.Time, callerFile string, callerLine int, cause *eventCause, fields []Field) {
+	fallback := c.fallback.Load()
+	if fallback == nil {
+		return
+	}
+
+	event := c.newEvent(c.eventContext(ctx), signal, severity, timestamp, fields...)
+	event.callerFile, event.callerLine = callerFile, callerLine
+	event.cause = cause
+
+	if c.syncMode {
+		c.invokeListener(signal, fallback, event)
+		c.pool.Put(event)
+		return
+	}
+
+	select {
+	case <-fallback.queue.done:
+		// Fallback closed after it was loaded
+		c.pool.Put(event)
+	default:
+		c.pushBuffered(fallback.queue, event)
+	}
+}
+
+// unheard reports whether signal retains history but has no listeners, so its
+// events are recorded for replay and also routed to the fallback listener.
+// Must be called after observers have been attached to signal.
+func (c *Capitan) unheard(signal Signal) bool {
+	if c.history[signal] == nil || c.fallback.Load() == nil {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.registry[signal]) == 0
+}
+
+// routeFallbackBatch routes each field set of a batch to the fallback listener.
+func (c *Capitan) routeFallbackBatch(signal Signal, callerFile string, callerLine int, batch []admittedSet) {
+	if c.fallback.Load() == nil {
+		return
+	}
+	for _, set := range batch {
+		if set.ctx.Err() != nil {
+			return
+		}
+		c.routeFallback(set.ctx, signal, SeverityInfo, c.clock(), callerFile, callerLine, nil, set.fields)
+	}
+}
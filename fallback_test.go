@@ -0,0 +1,157 @@
+package capitan
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHookDefault(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	routed := NewSignal("test.fallback.routed", "Test routed signal")
+	typo := NewSignal("test.fallback.tyop", "Test unrouted signal")
+	orderID := NewStringKey("order_id")
+
+	got := make(chan *Event, 4)
+	var unrouted []Signal
+	fallback := c.HookDefault(func(_ context.Context, e *Event) {
+		unrouted = append(unrouted, e.Signal())
+		got <- e
+	})
+	c.Hook(routed, func(context.Context, *Event) {})
+
+	c.Emit(context.Background(), routed)
+	c.Emit(context.Background(), typo, orderID.Field("A1"))
+
+	select {
+	case e := <-got:
+		if e.Signal() != typo {
+			t.Errorf("expected fallback event on %s, got %s", typo.Name(), e.Signal().Name())
+		}
+		if f, ok := e.Get(orderID).(GenericField[string]); !ok || f.Value() != "A1" {
+			t.Errorf("expected emitted fields on fallback event, got %v", e.Get(orderID))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fallback")
+	}
+	_ = c.Flush(context.Background())
+	if len(unrouted) != 1 {
+		t.Errorf("expected only the unrouted signal to reach the fallback, got %v", unrouted)
+	}
+
+	// Closing the fallback restores dropping
+	fallback.Close()
+	if fallback.Active() {
+		t.Error("expected closed fallback to be inactive")
+	}
+	c.Emit(context.Background(), typo)
+	_ = c.Flush(context.Background())
+	if len(unrouted) != 1 {
+		t.Errorf("expected no fallback delivery after Close, got %v", unrouted)
+	}
+}
+
+func TestHookDefaultSync(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.fallback.sync", "Test sync fallback signal")
+	observed := NewSignal("test.fallback.observed", "Test observed signal")
+
+	var unrouted []Signal
+	c.HookDefault(func(_ context.Context, e *Event) { unrouted = append(unrouted, e.Signal()) })
+	observer := c.Observe(func(context.Context, *Event) {}, observed)
+	defer observer.Close()
+
+	c.Emit(context.Background(), sig)
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, nil})
+	c.Emit(context.Background(), observed)
+
+	// Signals with only an observer are routed, not unrouted
+	if len(unrouted) != 3 || unrouted[0] != sig {
+		t.Errorf("expected 3 unrouted events on %s, got %v", sig.Name(), unrouted)
+	}
+}
+
+func TestHookDefaultWithHistory(t *testing.T) {
+	for _, mode := range []struct {
+		name string
+		opts []Option
+	}{
+		{"async", nil},
+		{"sync", []Option{WithSyncMode()}},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			sig := NewSignal("test.fallback.history", "Test fallback with history signal")
+			c := New(append(mode.opts, WithEventHistory(sig, 5))...)
+			defer c.Shutdown()
+
+			var unrouted atomic.Int32
+			c.HookDefault(func(context.Context, *Event) { unrouted.Add(1) })
+
+			c.Emit(context.Background(), sig)
+			c.EmitBatch(context.Background(), sig, [][]Field{nil})
+			_ = c.Flush(context.Background())
+			if got := unrouted.Load(); got != 2 {
+				t.Errorf("expected 2 events without listeners to reach the fallback, got %d", got)
+			}
+
+			// The events were still retained for replay
+			replayed := make(chan struct{}, 4)
+			c.HookReplay(sig, func(context.Context, *Event) { replayed <- struct{}{} })
+			_ = c.Flush(context.Background())
+			if len(replayed) != 2 {
+				t.Errorf("expected 2 replayed events, got %d", len(replayed))
+			}
+
+			// Once hooked, the signal no longer reaches the fallback
+			c.Emit(context.Background(), sig)
+			_ = c.Flush(context.Background())
+			if got := unrouted.Load(); got != 2 {
+				t.Errorf("expected hooked signal to bypass the fallback, got %d", got)
+			}
+		})
+	}
+}
+
+func TestHookDefaultReplaces(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.fallback.replace", "Test fallback replace signal")
+	var first, second int
+	previous := c.HookDefault(func(context.Context, *Event) { first++ })
+	current := c.HookDefault(func(context.Context, *Event) { second++ })
+
+	c.Emit(context.Background(), sig)
+	if first != 0 || second != 1 {
+		t.Errorf("expected only the newest fallback to run, got %d and %d", first, second)
+	}
+	if previous.Active() || !current.Active() {
+		t.Error("expected hooking a fallback to close the previous one")
+	}
+
+	// Closing the replaced fallback leaves the current one in place
+	previous.Close()
+	c.Emit(context.Background(), sig)
+	if second != 2 {
+		t.Errorf("expected current fallback to keep receiving, got %d", second)
+	}
+}
+
+func TestHookDefaultPanic(t *testing.T) {
+	var panicked Signal
+	c := New(WithSyncMode(), WithPanicHandler(func(signal Signal, _ any) { panicked = signal }))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.fallback.panic", "Test fallback panic signal")
+	c.HookDefault(func(context.Context, *Event) { panic("fallback boom") })
+
+	c.Emit(context.Background(), sig)
+	if panicked != sig {
+		t.Errorf("expected fallback panic reported on %s, got %s", sig.Name(), panicked.Name())
+	}
+}
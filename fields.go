@@ -1,6 +1,9 @@
 package capitan
 
-import "time"
+import (
+	"sort"
+	"time"
+)
 
 // GenericKey is a Key implementation for any type T.
 // All built-in key types (StringKey, IntKey, etc.) are aliases of GenericKey[T].
@@ -22,6 +25,7 @@ func (k GenericKey[T]) Field(value T) Field {
 
 // From extracts the typed value for this key from the event.
 // Returns the value and true if present, or zero value and false if not present or wrong type.
+// An AnyKey matches any field with its name, returning the field's Value().
 func (k GenericKey[T]) From(e *Event) (T, bool) {
 	var zero T
 	f := e.Get(k)
@@ -31,6 +35,13 @@ func (k GenericKey[T]) From(e *Event) (T, bool) {
 	if gf, ok := f.(GenericField[T]); ok {
 		return gf.Get(), true
 	}
+	if lf, ok := f.(lazyField[T]); ok {
+		return lf.Get(), true
+	}
+	if v, ok := any(&zero).(*any); ok {
+		*v = f.Value()
+		return zero, true
+	}
 	return zero, false
 }
 
@@ -157,3 +168,66 @@ type ErrorKey = GenericKey[error]
 func NewErrorKey(name string) ErrorKey {
 	return GenericKey[error]{name: name, variant: VariantError}
 }
+
+// AnyKey is a Key implementation for values of any type.
+// From on an AnyKey returns the value of any field with a matching name,
+// regardless of the field's concrete type.
+type AnyKey = GenericKey[any]
+
+// NewAnyKey creates an AnyKey with the given name.
+func NewAnyKey(name string) AnyKey {
+	return GenericKey[any]{name: name, variant: VariantAny}
+}
+
+// FieldsFromMap converts a map of name to value into fields suitable for Emit.
+// Variants are inferred for the built-in types; values of any other type
+// become VariantAny fields. Fields are returned ordered by name.
+func FieldsFromMap(m map[string]any) []Field {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]Field, 0, len(m))
+	for _, name := range names {
+		fields = append(fields, fieldFromValue(name, m[name]))
+	}
+	return fields
+}
+
+// fieldFromValue builds a field for value, inferring its variant from the Go type.
+func fieldFromValue(name string, value any) Field {
+	switch v := value.(type) {
+	case string:
+		return NewStringKey(name).Field(v)
+	case int:
+		return NewIntKey(name).Field(v)
+	case int32:
+		return NewInt32Key(name).Field(v)
+	case int64:
+		return NewInt64Key(name).Field(v)
+	case uint:
+		return NewUintKey(name).Field(v)
+	case uint32:
+		return NewUint32Key(name).Field(v)
+	case uint64:
+		return NewUint64Key(name).Field(v)
+	case float32:
+		return NewFloat32Key(name).Field(v)
+	case float64:
+		return NewFloat64Key(name).Field(v)
+	case bool:
+		return NewBoolKey(name).Field(v)
+	case time.Time:
+		return NewTimeKey(name).Field(v)
+	case time.Duration:
+		return NewDurationKey(name).Field(v)
+	case []byte:
+		return NewBytesKey(name).Field(v)
+	case error:
+		return NewErrorKey(name).Field(v)
+	default:
+		return NewAnyKey(name).Field(v)
+	}
+}
@@ -2,8 +2,10 @@ package capitan
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestStringKey(t *testing.T) {
@@ -603,3 +605,91 @@ func TestErrorKey(t *testing.T) {
 		t.Errorf("expected variant %v, got %v", VariantError, key.Variant())
 	}
 }
+
+func TestFieldsFromMapVariants(t *testing.T) {
+	type custom struct{ ID string }
+
+	m := map[string]any{
+		"string":   "s",
+		"int":      1,
+		"int64":    int64(2),
+		"float64":  3.5,
+		"bool":     true,
+		"time":     time.Unix(100, 0),
+		"duration": time.Second,
+		"bytes":    []byte("b"),
+		"error":    errors.New("boom"),
+		"custom":   custom{ID: "c"},
+	}
+
+	expected := map[string]Variant{
+		"string":   VariantString,
+		"int":      VariantInt,
+		"int64":    VariantInt64,
+		"float64":  VariantFloat64,
+		"bool":     VariantBool,
+		"time":     VariantTime,
+		"duration": VariantDuration,
+		"bytes":    VariantBytes,
+		"error":    VariantError,
+		"custom":   VariantAny,
+	}
+
+	fields := FieldsFromMap(m)
+	if len(fields) != len(expected) {
+		t.Fatalf("expected %d fields, got %d", len(expected), len(fields))
+	}
+
+	for i, field := range fields {
+		name := field.Key().Name()
+		if i > 0 && fields[i-1].Key().Name() >= name {
+			t.Errorf("expected fields sorted by name, got %q after %q", name, fields[i-1].Key().Name())
+		}
+		if field.Variant() != expected[name] {
+			t.Errorf("field %q: expected variant %q, got %q", name, expected[name], field.Variant())
+		}
+	}
+}
+
+func TestFieldsFromMapRoundTrip(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.map.roundtrip", "Test map round trip signal")
+	testErr := errors.New("boom")
+
+	original := map[string]any{
+		"string":   "s",
+		"int":      1,
+		"int64":    int64(2),
+		"float64":  3.5,
+		"bool":     true,
+		"time":     time.Unix(100, 0),
+		"duration": time.Second,
+		"bytes":    []byte("b"),
+		"error":    testErr,
+	}
+
+	var values map[string]any
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		values = e.ValuesMap()
+	})
+
+	c.Emit(context.Background(), sig, FieldsFromMap(original)...)
+
+	if len(values) != len(original) {
+		t.Fatalf("expected %d values, got %d", len(original), len(values))
+	}
+	for name, want := range original {
+		got := values[name]
+		if b, ok := want.([]byte); ok {
+			if string(got.([]byte)) != string(b) {
+				t.Errorf("%q: expected %v, got %v", name, want, got)
+			}
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: expected %v, got %v", name, want, got)
+		}
+	}
+}
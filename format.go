@@ -0,0 +1,194 @@
+package capitan
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces fields named by WithRedactedFields in rendered output.
+const RedactedValue = "[REDACTED]"
+
+// maxFormattedBytes caps how many bytes of a []byte field are rendered as hex.
+const maxFormattedBytes = 16
+
+// String renders the event in a human-readable single-line form:
+//
+//	[INFO] order.created #42 @2024-01-02T15:04:05Z order_id="ORDER-123" total=99.99
+//
+// The #-prefixed number is the event's Sequence. Fields are ordered by name.
+// Strings are quoted, and byte slices are rendered as hex up to 16 bytes,
+// with longer slices truncated and annotated with their length. String only
+// reads the event and is safe to call from within a listener.
+func (e *Event) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	b.WriteString(string(e.severity))
+	b.WriteString("] ")
+	b.WriteString(e.signal.name)
+	b.WriteString(" #")
+	b.WriteString(strconv.FormatUint(e.sequence, 10))
+	b.WriteString(" @")
+	b.WriteString(e.timestamp.Format(time.RFC3339Nano))
+
+	names := make([]string, 0, len(e.fields))
+	for name := range e.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	f := &textFormatter{b: &b}
+	for _, name := range names {
+		if e.isRedacted(name) {
+			f.String(name, RedactedValue)
+			continue
+		}
+		if v, ok := logValue(e.fields[name]); ok {
+			f.Default(name, v)
+			continue
+		}
+		visitField(f, name, e.fields[name])
+	}
+	return b.String()
+}
+
+// logValue returns the field's LogValue when its value implements LogValuer.
+func logValue(f Field) (any, bool) {
+	if lv, ok := f.Value().(LogValuer); ok {
+		return lv.LogValue(), true
+	}
+	return nil, false
+}
+
+// textFormatter is a FieldVisitor that appends name=value pairs to a builder.
+type textFormatter struct {
+	b *strings.Builder
+}
+
+func (f *textFormatter) write(name, value string) {
+	f.b.WriteByte(' ')
+	f.b.WriteString(name)
+	f.b.WriteByte('=')
+	f.b.WriteString(value)
+}
+
+func (f *textFormatter) String(name string, v string) { f.write(name, strconv.Quote(v)) }
+func (f *textFormatter) Int(name string, v int)       { f.write(name, strconv.Itoa(v)) }
+func (f *textFormatter) Int32(name string, v int32)   { f.write(name, strconv.FormatInt(int64(v), 10)) }
+func (f *textFormatter) Int64(name string, v int64)   { f.write(name, strconv.FormatInt(v, 10)) }
+func (f *textFormatter) Uint(name string, v uint)     { f.write(name, strconv.FormatUint(uint64(v), 10)) }
+func (f *textFormatter) Uint32(name string, v uint32) {
+	f.write(name, strconv.FormatUint(uint64(v), 10))
+}
+func (f *textFormatter) Uint64(name string, v uint64) { f.write(name, strconv.FormatUint(v, 10)) }
+func (f *textFormatter) Float32(name string, v float32) {
+	f.write(name, strconv.FormatFloat(float64(v), 'g', -1, 32))
+}
+func (f *textFormatter) Float64(name string, v float64) {
+	f.write(name, strconv.FormatFloat(v, 'g', -1, 64))
+}
+func (f *textFormatter) Bool(name string, v bool)              { f.write(name, strconv.FormatBool(v)) }
+func (f *textFormatter) Time(name string, v time.Time)         { f.write(name, v.Format(time.RFC3339Nano)) }
+func (f *textFormatter) Duration(name string, v time.Duration) { f.write(name, v.String()) }
+
+func (f *textFormatter) Bytes(name string, v []byte) {
+	if len(v) <= maxFormattedBytes {
+		f.write(name, "0x"+hex.EncodeToString(v))
+		return
+	}
+	f.write(name, "0x"+hex.EncodeToString(v[:maxFormattedBytes])+"...("+strconv.Itoa(len(v))+" bytes)")
+}
+
+func (f *textFormatter) Error(name string, v error) {
+	if v == nil {
+		f.write(name, "<nil>")
+		return
+	}
+	f.write(name, strconv.Quote(v.Error()))
+}
+
+func (f *textFormatter) Default(name string, v any) {
+	if raw, ok := v.(json.RawMessage); ok {
+		f.write(name, string(raw))
+		return
+	}
+	f.write(name, fmt.Sprintf("%+v", v))
+}
+
+// isRedacted reports whether the named field is masked in rendered output.
+func (e *Event) isRedacted(name string) bool {
+	_, ok := e.redacted[name]
+	return ok
+}
+
+// eventJSON is the wire form produced by Event.MarshalJSON.
+type eventJSON struct {
+	Signal    string         `json:"signal"`
+	Severity  Severity       `json:"severity"`
+	Timestamp time.Time      `json:"timestamp"`
+	Sequence  uint64         `json:"sequence"`
+	Fields    map[string]any `json:"fields"`
+}
+
+// MarshalJSON renders the event as an object with signal, severity,
+// timestamp, sequence, and a fields object of field values. Values that
+// implement LogValuer are rendered as their LogValue, error values as their
+// message (TracedError as an object with the message and stack), URLs as
+// strings, and fields named by WithRedactedFields as RedactedValue.
+func (e *Event) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]any, len(e.fields))
+	for name, field := range e.fields {
+		if e.isRedacted(name) {
+			fields[name] = RedactedValue
+			continue
+		}
+		if v, ok := logValue(field); ok {
+			fields[name] = v
+			continue
+		}
+		if _, ok := field.Value().(json.Marshaler); ok {
+			fields[name] = field.Value()
+			continue
+		}
+		if err, ok := field.Value().(error); ok {
+			fields[name] = err.Error()
+			continue
+		}
+		if u, ok := field.Value().(*url.URL); ok && u != nil {
+			fields[name] = u.String()
+			continue
+		}
+		fields[name] = field.Value()
+	}
+	return json.Marshal(eventJSON{
+		Signal:    e.signal.name,
+		Severity:  e.severity,
+		Timestamp: e.timestamp,
+		Sequence:  e.sequence,
+		Fields:    fields,
+	})
+}
+
+// LogValue implements slog.LogValuer, so an event passed as a log attribute
+// renders as a group of signal, severity, and its fields ordered by name.
+// Fields named by WithRedactedFields are rendered as RedactedValue.
+// The value is resolved when logged; don't pass events to asynchronous handlers.
+func (e *Event) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(e.fields)+2)
+	attrs = append(attrs, slog.String("signal", e.signal.name), slog.String("severity", string(e.severity)))
+	for _, field := range e.FieldsSorted() {
+		name := field.Key().Name()
+		if e.isRedacted(name) {
+			attrs = append(attrs, slog.String(name, RedactedValue))
+			continue
+		}
+		attrs = append(attrs, FieldToSlogAttr(field))
+	}
+	return slog.GroupValue(attrs...)
+}
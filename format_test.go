@@ -0,0 +1,227 @@
+package capitan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventString(t *testing.T) {
+	sig := NewSignal("order.created", "Order created")
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type orderInfo struct {
+		ID    string
+		Items int
+	}
+
+	tests := []struct {
+		name     string
+		severity Severity
+		fields   []Field
+		expected string
+	}{
+		{
+			name:     "no fields",
+			severity: SeverityDebug,
+			expected: `[DEBUG] order.created #42 @2024-01-02T15:04:05Z`,
+		},
+		{
+			name:     "string and float",
+			severity: SeverityInfo,
+			fields: []Field{
+				NewStringKey("order_id").Field("ORDER-123"),
+				NewFloat64Key("total").Field(99.99),
+			},
+			expected: `[INFO] order.created #42 @2024-01-02T15:04:05Z order_id="ORDER-123" total=99.99`,
+		},
+		{
+			name:     "sorted numeric, bool, duration",
+			severity: SeverityWarn,
+			fields: []Field{
+				NewIntKey("zeta").Field(-7),
+				NewUint64Key("alpha").Field(42),
+				NewBoolKey("mid").Field(true),
+				NewDurationKey("elapsed").Field(1500 * time.Millisecond),
+			},
+			expected: `[WARN] order.created #42 @2024-01-02T15:04:05Z alpha=42 elapsed=1.5s mid=true zeta=-7`,
+		},
+		{
+			name:     "error, time and quoted string",
+			severity: SeverityError,
+			fields: []Field{
+				NewErrorKey("err").Field(errors.New("payment declined")),
+				NewTimeKey("at").Field(ts),
+				NewStringKey("note").Field(`say "hi"`),
+			},
+			expected: `[ERROR] order.created #42 @2024-01-02T15:04:05Z at=2024-01-02T15:04:05Z err="payment declined" note="say \"hi\""`,
+		},
+		{
+			name:     "short and long bytes",
+			severity: SeverityInfo,
+			fields: []Field{
+				NewBytesKey("short").Field([]byte("hi")),
+				NewBytesKey("long").Field([]byte("0123456789abcdefXYZ")),
+			},
+			expected: `[INFO] order.created #42 @2024-01-02T15:04:05Z long=0x30313233343536373839616263646566...(19 bytes) short=0x6869`,
+		},
+		{
+			name:     "custom variant",
+			severity: SeverityInfo,
+			fields: []Field{
+				NewKey[orderInfo]("order", "test.OrderInfo").Field(orderInfo{ID: "A", Items: 2}),
+			},
+			expected: `[INFO] order.created #42 @2024-01-02T15:04:05Z order={ID:A Items:2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := newEvent(context.Background(), sig, tt.severity, ts, tt.fields...)
+			event.sequence = 42
+			if got := event.String(); got != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEventStringInsideListener(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.string", "Test string signal")
+	key := NewStringKey("value")
+
+	var first, second string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		first = e.String()
+		second = e.String()
+	})
+
+	c.Emit(context.Background(), sig, key.Field("x"))
+
+	if first == "" || first != second {
+		t.Errorf("expected stable non-empty rendering, got %q and %q", first, second)
+	}
+}
+
+func TestEventMarshalJSON(t *testing.T) {
+	sig := NewSignal("order.created", "Order created")
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	e := newEvent(context.Background(), sig, SeverityWarn, ts,
+		NewStringKey("order_id").Field("ORDER-123"),
+		NewIntKey("items").Field(3),
+		NewErrorKey("err").Field(errors.New("boom")),
+	)
+	defer eventPool.Put(e)
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	want := `{"signal":"order.created","severity":"WARN","timestamp":"2024-01-02T15:04:05Z","sequence":` +
+		strconv.FormatUint(e.Sequence(), 10) + `,"fields":{"err":"boom","items":3,"order_id":"ORDER-123"}}`
+	if string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+// testCard is a custom field value that masks itself when rendered.
+type testCard struct {
+	Number string
+}
+
+func (c testCard) LogValue() any {
+	return "****" + c.Number[len(c.Number)-4:]
+}
+
+func TestLogValuer(t *testing.T) {
+	sig := NewSignal("payment.made", "Payment made")
+	card := NewKey[testCard]("card", "test.card")
+	e := newEvent(context.Background(), sig, SeverityInfo, time.Now(), card.Field(testCard{Number: "4111111111111111"}))
+	defer eventPool.Put(e)
+
+	if got := card.Field(testCard{Number: "4111111111111111"}).(GenericField[testCard]).LogValue(); got != "****1111" {
+		t.Errorf("expected GenericField.LogValue to use the value's LogValue, got %v", got)
+	}
+	if got := NewIntKey("n").Field(7).(GenericField[int]).LogValue(); got != 7 {
+		t.Errorf("expected plain values returned as is, got %v", got)
+	}
+
+	if s := e.String(); !strings.Contains(s, "card=****1111") || strings.Contains(s, "4111111111111111") {
+		t.Errorf("expected String to render LogValue, got %s", s)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"card":"****1111"`) {
+		t.Errorf("expected JSON to render LogValue, got %s", data)
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("paid", "event", e)
+	if !strings.Contains(buf.String(), `"card":"****1111"`) {
+		t.Errorf("expected slog to render LogValue, got %s", buf.String())
+	}
+
+	if got, _ := card.From(e); got.Number != "4111111111111111" {
+		t.Errorf("expected listeners to see the raw value, got %v", got)
+	}
+}
+
+func TestWithRedactedFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	c := New(WithSyncMode(), WithRedactedFields("email", "ssn"))
+	defer c.Shutdown()
+
+	sig := NewSignal("user.created", "User created")
+	email := NewStringKey("email")
+	plan := NewStringKey("plan")
+
+	var text, rendered string
+	var listenerSaw string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		listenerSaw, _ = email.From(e)
+		text = e.String()
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Errorf("marshal failed: %v", err)
+		}
+		rendered = string(data)
+		logger.Info("event", "event", e)
+	})
+	c.Emit(context.Background(), sig, email.Field("a@example.com"), plan.Field("pro"))
+
+	if listenerSaw != "a@example.com" {
+		t.Errorf("expected listeners to read the real value, got %q", listenerSaw)
+	}
+	for name, out := range map[string]string{"String": text, "JSON": rendered, "slog": buf.String()} {
+		if strings.Contains(out, "a@example.com") || !strings.Contains(out, RedactedValue) {
+			t.Errorf("%s: expected email redacted, got %s", name, out)
+		}
+		if !strings.Contains(out, "pro") {
+			t.Errorf("%s: expected other fields intact, got %s", name, out)
+		}
+	}
+	if want := `event.signal=user.created event.severity=INFO event.email=[REDACTED] event.plan=pro`; !strings.Contains(buf.String(), want) {
+		t.Errorf("expected slog group %q, got %s", want, buf.String())
+	}
+}
@@ -0,0 +1,109 @@
+package capitan
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Forward delivers every event emitted on from to to's listeners as well,
+// on the default instance.
+func Forward(from, to Signal) (stop func()) {
+	return defaultInstance().Forward(from, to)
+}
+
+// Forward delivers every event emitted on from to to's listeners as well,
+// with identical fields, severity, timestamp, and context. Useful when
+// renaming a signal: keep emitting the old name while listeners move to the
+// new one. Call stop to remove the forward; stop is idempotent.
+//
+// Forwards are transitive, and each emit reaches every signal at most once,
+// so cycles such as Forward(a, b) plus Forward(b, a) cannot loop. Each
+// forwarded delivery is its own pooled event processed by the target's worker.
+// Pre-emit hooks and field limits apply only to the emitted signal.
+func (c *Capitan) Forward(from, to Signal) (stop func()) {
+	c.mu.Lock()
+	c.forwards[from] = append(c.forwards[from], to)
+	c.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			targets := c.forwards[from]
+			if i := slices.Index(targets, to); i >= 0 {
+				targets = slices.Delete(targets, i, i+1)
+			}
+			if len(targets) == 0 {
+				delete(c.forwards, from)
+			} else {
+				c.forwards[from] = targets
+			}
+		})
+	}
+}
+
+// forwardTargets returns the signals an emit on signal is forwarded to,
+// following forwards transitively. The emitted signal itself is excluded and
+// each target appears once, which breaks forwarding cycles.
+func (c *Capitan) forwardTargets(signal Signal) []Signal {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.forwards[signal]) == 0 {
+		return nil
+	}
+
+	var targets []Signal
+	seen := map[Signal]bool{signal: true}
+	queue := []Signal{signal}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, to := range c.forwards[next] {
+			if seen[to] {
+				continue
+			}
+			seen[to] = true
+			targets = append(targets, to)
+			queue = append(queue, to)
+		}
+	}
+	return targets
+}
+
+// Route registers a listener on from that passes each event through transform
+// and re-emits the returned fields on to, with the event's context and
+// severity. Returning false filters the event out. transform runs inside
+// from's worker with the same panic recovery as any listener, and must not
+// retain the event after returning. Call stop to remove the route.
+func Route(c *Capitan, from, to Signal, transform func(*Event) ([]Field, bool)) (stop func()) {
+	listener := c.hookInternal(from, func(ctx context.Context, e *Event) {
+		if fields, ok := transform(e); ok {
+			c.EmitSeverity(ctx, to, e.Severity(), fields...)
+		}
+	})
+	return listener.Close
+}
+
+// SourceSignalKey is the field FanIn adds to each event, holding the name of
+// the input signal it came from.
+var SourceSignalKey = NewStringKey("source_signal")
+
+// FanIn routes events from every input signal onto out, appending a
+// SourceSignalKey field so consumers can still tell where each event came
+// from. Context and severity are preserved. Call stop to detach all inputs.
+func FanIn(c *Capitan, out Signal, in ...Signal) (stop func()) {
+	stops := make([]func(), 0, len(in))
+	for _, signal := range in {
+		source := SourceSignalKey.Field(signal.Name())
+		stops = append(stops, Route(c, signal, out, func(e *Event) ([]Field, bool) {
+			return append(e.Fields(), source), true
+		}))
+	}
+	return func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestForward verifies listeners on both names receive one invocation per emit.
+func TestForward(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	oldSig := NewSignal("test.forward.old", "Test forward old signal")
+	newSig := NewSignal("test.forward.new", "Test forward new signal")
+	id := NewStringKey("id")
+
+	var oldCalls, newCalls atomic.Int32
+	var gotID atomic.Value
+	c.Hook(oldSig, func(_ context.Context, _ *Event) { oldCalls.Add(1) })
+	c.Hook(newSig, func(_ context.Context, e *Event) {
+		newCalls.Add(1)
+		if v, ok := id.From(e); ok {
+			gotID.Store(v)
+		}
+	})
+
+	stop := c.Forward(oldSig, newSig)
+	c.Warn(context.Background(), oldSig, id.Field("42"))
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	if oldCalls.Load() != 1 || newCalls.Load() != 1 {
+		t.Errorf("expected one call per listener, got old=%d new=%d", oldCalls.Load(), newCalls.Load())
+	}
+	if gotID.Load() != "42" {
+		t.Errorf("expected forwarded fields, got %v", gotID.Load())
+	}
+
+	stop()
+	stop()
+	c.Emit(context.Background(), oldSig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if oldCalls.Load() != 2 || newCalls.Load() != 1 {
+		t.Errorf("expected forwarding stopped, got old=%d new=%d", oldCalls.Load(), newCalls.Load())
+	}
+}
+
+// TestForwardCycle verifies mutual forwards deliver once per signal instead of looping.
+func TestForwardCycle(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	a := NewSignal("test.forward.a", "Test forward a signal")
+	b := NewSignal("test.forward.b", "Test forward b signal")
+	d := NewSignal("test.forward.d", "Test forward d signal")
+	c.Forward(a, b)
+	c.Forward(b, a)
+	c.Forward(b, d)
+
+	calls := map[Signal]int{}
+	for _, sig := range []Signal{a, b, d} {
+		c.Hook(sig, func(_ context.Context, e *Event) {
+			calls[e.Signal()]++
+			if e.Severity() != SeverityError {
+				t.Errorf("expected severity preserved on %s, got %s", e.Signal().Name(), e.Severity())
+			}
+		})
+	}
+
+	c.Error(context.Background(), a)
+
+	for _, sig := range []Signal{a, b, d} {
+		if calls[sig] != 1 {
+			t.Errorf("expected %s delivered once, got %d", sig.Name(), calls[sig])
+		}
+	}
+}
+
+// TestRoute verifies pass-through, field transformation, and filtering.
+func TestRoute(t *testing.T) {
+	c := New(WithSyncMode(), WithPanicHandler(func(Signal, any) {}))
+	defer c.Shutdown()
+
+	orders := NewSignal("test.route.order", "Test route order signal")
+	invoices := NewSignal("test.route.invoice", "Test route invoice signal")
+	total := NewIntKey("total")
+	cents := NewIntKey("cents")
+
+	type routed struct {
+		severity Severity
+		fields   map[string]any
+	}
+	var got []routed
+	c.Hook(invoices, func(_ context.Context, e *Event) {
+		got = append(got, routed{e.Severity(), e.FieldsMap()})
+	})
+
+	stop := Route(c, orders, invoices, func(e *Event) ([]Field, bool) {
+		v, ok := total.From(e)
+		if !ok {
+			return e.Fields(), true // pass through unchanged
+		}
+		if v == 0 {
+			return nil, false // filter free orders
+		}
+		if v < 0 {
+			panic("negative total")
+		}
+		return []Field{cents.Field(v * 100)}, true
+	})
+
+	ctx := context.Background()
+	c.Emit(ctx, orders, NewStringKey("id").Field("o-1"))
+	c.Warn(ctx, orders, total.Field(5))
+	c.Emit(ctx, orders, total.Field(0))
+	c.Emit(ctx, orders, total.Field(-1))
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 routed events, got %d", len(got))
+	}
+	if got[0].fields["id"] != "o-1" {
+		t.Errorf("expected pass-through fields, got %v", got[0].fields)
+	}
+	if got[1].fields["cents"] != 500 || got[1].severity != SeverityWarn {
+		t.Errorf("expected transformed warn event with 500 cents, got %v %s", got[1].fields, got[1].severity)
+	}
+
+	stop()
+	c.Emit(ctx, orders, total.Field(1))
+	if len(got) != 2 {
+		t.Errorf("expected no routing after stop, got %d events", len(got))
+	}
+}
+
+// TestFanIn verifies events from every input reach the output tagged with their source.
+func TestFanIn(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	card := NewSignal("test.fanin.card", "Test fan-in card signal")
+	ach := NewSignal("test.fanin.ach", "Test fan-in ach signal")
+	settled := NewSignal("test.fanin.settled", "Test fan-in settled signal")
+	amount := NewIntKey("amount")
+
+	var mu sync.Mutex
+	sources := map[string]int{}
+	c.Hook(settled, func(_ context.Context, e *Event) {
+		source, _ := SourceSignalKey.From(e)
+		if v, ok := amount.From(e); !ok || v != 10 {
+			t.Errorf("expected original fields preserved, got %v", e.FieldsMap())
+		}
+		mu.Lock()
+		sources[source]++
+		mu.Unlock()
+	})
+
+	stop := FanIn(c, settled, card, ach)
+	ctx := context.Background()
+	c.Emit(ctx, card, amount.Field(10))
+	c.Emit(ctx, ach, amount.Field(10))
+	c.Emit(ctx, ach, amount.Field(10))
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	stop()
+	c.Emit(ctx, card, amount.Field(10))
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if sources[card.Name()] != 1 || sources[ach.Name()] != 2 {
+		t.Errorf("expected 1 card and 2 ach events, got %v", sources)
+	}
+}
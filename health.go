@@ -0,0 +1,61 @@
+package capitan
+
+import "time"
+
+// HealthReport describes signals whose workers appear stuck.
+type HealthReport struct {
+	// Healthy is true when no signal was flagged.
+	Healthy bool
+
+	// Unhealthy lists flagged signals, in no particular order.
+	Unhealthy []SignalHealth
+}
+
+// SignalHealth describes the queue state of a flagged signal.
+type SignalHealth struct {
+	Signal   Signal
+	Depth    int
+	Capacity int
+
+	// FullFor is how long the queue has been at capacity; zero if not full.
+	FullFor time.Duration
+
+	// OldestAge is the age of the oldest pending event, measured from its
+	// timestamp; zero if the worker is idle.
+	OldestAge time.Duration
+}
+
+// Health reports signals whose queue has stayed at capacity longer than the
+// stuck threshold, or whose oldest pending event exceeds the max age.
+// Thresholds are set with WithHealthThresholds. Sync mode has no queues and
+// always reports healthy.
+func (c *Capitan) Health() HealthReport {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := c.clock().UnixNano()
+	report := HealthReport{Healthy: true}
+
+	for signal, worker := range c.workers {
+		status := SignalHealth{
+			Signal:   signal,
+			Depth:    len(worker.events),
+			Capacity: cap(worker.events),
+		}
+		if since := worker.fullSince.Load(); since != 0 && status.Depth == status.Capacity {
+			status.FullFor = time.Duration(now - since)
+		}
+		if ts := worker.current.Load(); ts != 0 {
+			status.OldestAge = time.Duration(now - ts)
+		}
+
+		stuck := c.stuckAfter > 0 && status.FullFor >= c.stuckAfter
+		stale := c.maxEventAge > 0 && status.OldestAge >= c.maxEventAge
+		if stuck || stale {
+			report.Healthy = false
+			report.Unhealthy = append(report.Unhealthy, status)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,125 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestHealthFlagsBlockedListener verifies a signal whose listener is stuck is
+// flagged once its full queue exceeds the threshold.
+func TestHealthFlagsBlockedListener(t *testing.T) {
+	c := New(WithBufferSize(1), WithHealthThresholds(20*time.Millisecond, 0))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.health.blocked", "Test health blocked signal")
+	healthy := NewSignal("test.health.ok", "Test health ok signal")
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+	})
+	c.Hook(healthy, func(_ context.Context, _ *Event) {})
+
+	c.Emit(context.Background(), sig) // being processed
+	<-started
+	c.Emit(context.Background(), sig) // fills the queue
+	c.Emit(context.Background(), healthy)
+
+	// Blocks until the listener is released
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Emit(context.Background(), sig)
+	}()
+	defer func() {
+		close(release)
+		wg.Wait()
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	var report HealthReport
+	for time.Now().Before(deadline) {
+		report = c.Health()
+		if !report.Healthy {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if report.Healthy || len(report.Unhealthy) != 1 {
+		t.Fatalf("expected one unhealthy signal, got %+v", report)
+	}
+	status := report.Unhealthy[0]
+	if status.Signal != sig {
+		t.Errorf("expected %v flagged, got %v", sig, status.Signal)
+	}
+	if status.Depth != 1 || status.Capacity != 1 {
+		t.Errorf("expected depth 1/1, got %d/%d", status.Depth, status.Capacity)
+	}
+	if status.FullFor < 20*time.Millisecond {
+		t.Errorf("expected FullFor >= threshold, got %v", status.FullFor)
+	}
+	if status.OldestAge <= 0 {
+		t.Errorf("expected positive OldestAge, got %v", status.OldestAge)
+	}
+}
+
+// TestHealthMaxAge verifies a long-running event is flagged by age.
+func TestHealthMaxAge(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Unix(1000, 0)
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	c := New(WithClock(clock), WithHealthThresholds(0, time.Minute))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.health.age", "Test health age signal")
+	release := make(chan struct{})
+	started := make(chan struct{})
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		close(started)
+		<-release
+	})
+	defer close(release)
+
+	c.Emit(context.Background(), sig)
+	<-started
+
+	if report := c.Health(); !report.Healthy {
+		t.Fatalf("expected healthy before max age, got %+v", report)
+	}
+
+	mu.Lock()
+	now = now.Add(2 * time.Minute)
+	mu.Unlock()
+
+	report := c.Health()
+	if report.Healthy || len(report.Unhealthy) != 1 {
+		t.Fatalf("expected one unhealthy signal, got %+v", report)
+	}
+	if report.Unhealthy[0].OldestAge != 2*time.Minute {
+		t.Errorf("expected OldestAge 2m, got %v", report.Unhealthy[0].OldestAge)
+	}
+}
+
+// TestHealthSyncMode verifies sync mode reports healthy.
+func TestHealthSyncMode(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	if report := c.Health(); !report.Healthy || len(report.Unhealthy) != 0 {
+		t.Errorf("expected healthy report, got %+v", report)
+	}
+}
@@ -0,0 +1,143 @@
+package capitan
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// ReplayOrder determines the order HookReplay delivers retained history in.
+type ReplayOrder string
+
+const (
+	// ReplayOldestFirst replays history in the order it was processed. This is the default.
+	ReplayOldestFirst ReplayOrder = "oldest-first"
+
+	// ReplayNewestFirst replays the most recent event first.
+	ReplayNewestFirst ReplayOrder = "newest-first"
+)
+
+// eventHistory is a bounded per-signal record of processed events that evicts
+// its oldest entry when full. Entries are pooled clones owned by the history.
+type eventHistory struct {
+	mu       sync.Mutex
+	capacity int
+	events   []*Event
+}
+
+// record appends a copy of event, returning the evicted entry to the pool.
+func (h *eventHistory) record(pool *sync.Pool, event *Event) {
+	retained := event.clone(pool)
+	retained.ctx = context.WithoutCancel(event.ctx)
+	retained.target = nil
+	retained.results = nil
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.events) >= h.capacity {
+		pool.Put(h.events[0])
+		copy(h.events, h.events[1:])
+		h.events = h.events[:len(h.events)-1]
+	}
+	h.events = append(h.events, retained)
+}
+
+// snapshot returns copies of the retained entries, oldest first. The copies
+// are owned by the caller, who must return them to pool: the entries
+// themselves may be evicted and reused while the copies are being delivered.
+func (h *eventHistory) snapshot(pool *sync.Pool) []*Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	copies := make([]*Event, len(h.events))
+	for i, e := range h.events {
+		copies[i] = e.clone(pool)
+	}
+	return copies
+}
+
+// reset discards every retained entry, returning them to the pool.
+func (h *eventHistory) reset(pool *sync.Pool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, e := range h.events {
+		pool.Put(e)
+		h.events[i] = nil
+	}
+	h.events = h.events[:0]
+}
+
+// WithEventHistory retains the last size events processed on signal so
+// listeners registered with HookReplay can catch up on them. Retained events
+// keep their context's values but not its cancellation.
+func WithEventHistory(signal Signal, size int) Option {
+	return func(c *Capitan) {
+		if size <= 0 {
+			c.invalid("WithEventHistory: size for %s must be positive, got %d", signal.Name(), size)
+			return
+		}
+		if c.history == nil {
+			c.history = make(map[Signal]*eventHistory)
+		}
+		c.history[signal] = &eventHistory{capacity: size}
+	}
+}
+
+// WithReplayOrder sets the order HookReplay delivers history in.
+// Default is ReplayOldestFirst.
+func WithReplayOrder(order ReplayOrder) Option {
+	return func(c *Capitan) {
+		if order != ReplayOldestFirst && order != ReplayNewestFirst {
+			c.invalid("WithReplayOrder: unknown order %q", order)
+			return
+		}
+		c.replayOrder = order
+	}
+}
+
+// HookReplay registers a callback on the default instance that first
+// receives the signal's retained history.
+func HookReplay(signal Signal, callback EventCallback) *Listener {
+	return defaultInstance().HookReplay(signal, callback)
+}
+
+// HookReplay registers a callback for the given signal and, before returning,
+// replays the signal's retained history to it on the calling goroutine, in the
+// configured ReplayOrder. Live delivery to the listener waits until the replay
+// has finished, and every event is seen exactly once: either in the replay or
+// live, never both. Without WithEventHistory for the signal it behaves like Hook.
+//
+// In sync mode the callback must not emit on the same signal during replay,
+// as that emit would wait for the replay to finish.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookReplay(signal Signal, callback EventCallback) *Listener {
+	history := c.history[signal]
+	if history == nil {
+		return c.Hook(signal, callback)
+	}
+
+	listener := &Listener{
+		signal:   signal,
+		callback: callback,
+		capitan:  c,
+		ready:    make(chan struct{}),
+	}
+
+	// Workers record history and snapshot listeners under the read lock, so
+	// each event lands in exactly one of the replay or the live deliveries.
+	c.mu.Lock()
+	replay := history.snapshot(c.pool)
+	c.register(listener)
+	c.mu.Unlock()
+	defer close(listener.ready)
+
+	if c.replayOrder == ReplayNewestFirst {
+		slices.Reverse(replay)
+	}
+	for _, event := range replay {
+		if listener.Active() {
+			c.invokeListener(signal, listener, event)
+		}
+		c.pool.Put(event)
+	}
+	return listener
+}
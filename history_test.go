@@ -0,0 +1,208 @@
+package capitan
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHookReplay(t *testing.T) {
+	sig := NewSignal("test.replay", "Test replay signal")
+	c := New(WithSyncMode(), WithEventHistory(sig, 2))
+	defer c.Shutdown()
+
+	id := NewIntKey("id")
+	for i := 1; i <= 3; i++ {
+		c.Emit(context.Background(), sig, id.Field(i))
+	}
+
+	var got []int
+	c.HookReplay(sig, func(_ context.Context, e *Event) {
+		v, _ := id.From(e)
+		got = append(got, v)
+	})
+	c.Emit(context.Background(), sig, id.Field(4))
+
+	if len(got) != 3 || got[0] != 2 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("expected last two retained events then live event, got %v", got)
+	}
+}
+
+func TestHookReplayNewestFirst(t *testing.T) {
+	sig := NewSignal("test.replay.newest", "Test replay newest first signal")
+	c := New(WithSyncMode(), WithEventHistory(sig, 5), WithReplayOrder(ReplayNewestFirst))
+	defer c.Shutdown()
+
+	id := NewIntKey("id")
+	for i := 1; i <= 3; i++ {
+		c.Emit(context.Background(), sig, id.Field(i))
+	}
+
+	var got []int
+	c.HookReplay(sig, func(_ context.Context, e *Event) {
+		v, _ := id.From(e)
+		got = append(got, v)
+	})
+
+	if len(got) != 3 || got[0] != 3 || got[2] != 1 {
+		t.Errorf("expected newest first, got %v", got)
+	}
+}
+
+func TestWithReplayOrderInvalidKeepsPrevious(t *testing.T) {
+	sig := NewSignal("test.replay.invalid", "Test replay invalid order signal")
+	c := New(WithSyncMode(), WithEventHistory(sig, 5), WithReplayOrder(ReplayNewestFirst), WithReplayOrder("sideways"))
+	defer c.Shutdown()
+
+	if c.replayOrder != ReplayNewestFirst {
+		t.Errorf("expected invalid order to be ignored, got %q", c.replayOrder)
+	}
+}
+
+func TestHookReplayWithoutHistory(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.replay.none", "Test replay without history signal")
+	c.Emit(context.Background(), sig)
+
+	var calls int
+	c.HookReplay(sig, func(_ context.Context, _ *Event) { calls++ })
+	c.Emit(context.Background(), sig)
+
+	if calls != 1 {
+		t.Errorf("expected only the live event, got %d calls", calls)
+	}
+}
+
+func TestHookReplayAfterCloseSignal(t *testing.T) {
+	sig := NewSignal("test.replay.closed", "Test replay after close signal")
+	c := New(WithSyncMode(), WithEventHistory(sig, 5))
+	defer c.Shutdown()
+
+	id := NewIntKey("id")
+	c.Emit(context.Background(), sig, id.Field(1))
+	c.CloseSignal(sig)
+	c.Emit(context.Background(), sig, id.Field(2))
+
+	var got []int
+	c.HookReplay(sig, func(_ context.Context, e *Event) {
+		v, _ := id.From(e)
+		got = append(got, v)
+	})
+
+	if len(got) != 1 || got[0] != 2 {
+		t.Errorf("expected only the event emitted after CloseSignal, got %v", got)
+	}
+}
+
+func TestHookReplayExactlyOnce(t *testing.T) {
+	sig := NewSignal("test.replay.once", "Test replay exactly once signal")
+	const total = 500
+	c := New(WithEventHistory(sig, total), WithBufferSize(total))
+	defer c.Shutdown()
+
+	id := NewIntKey("id")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < total; i++ {
+			c.Emit(context.Background(), sig, id.Field(i))
+		}
+	}()
+
+	var mu sync.Mutex
+	var got []int
+	c.HookReplay(sig, func(_ context.Context, e *Event) {
+		v, _ := id.From(e)
+		mu.Lock()
+		got = append(got, v)
+		mu.Unlock()
+	})
+
+	wg.Wait()
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != total {
+		t.Fatalf("expected %d events, got %d", total, len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expected event %d at position %d, got %d", i, i, v)
+		}
+	}
+}
+
+// TestHookReplayConcurrentEmit verifies a replayed event isn't recycled for
+// another emission while the listener is still handling it.
+func TestHookReplayConcurrentEmit(t *testing.T) {
+	sig := NewSignal("test.replay.concurrent", "Test replay concurrent signal")
+	other := NewSignal("test.replay.concurrent.other", "Test replay concurrent other signal")
+	c := New(WithEventHistory(sig, 4))
+	defer c.Shutdown()
+
+	id := NewIntKey("id")
+	c.Hook(other, func(context.Context, *Event) {})
+	for i := 0; i < 4; i++ {
+		c.Emit(context.Background(), sig, id.Field(i))
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	// Evict history and draw events from the pool while the replay runs
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 100; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			c.Emit(context.Background(), sig, id.Field(i))
+			c.Emit(context.Background(), other, id.Field(-i))
+		}
+	}()
+
+	var replayed int
+	c.HookReplay(sig, func(_ context.Context, e *Event) {
+		if replayed >= 4 {
+			return // live deliveries
+		}
+		replayed++
+		// Retained entries are evicted to the pool; replay must deliver copies
+		history := c.history[sig]
+		history.mu.Lock()
+		retained := slices.Contains(history.events, e)
+		history.mu.Unlock()
+		if retained {
+			t.Error("expected replay to deliver a copy, got a retained history entry")
+		}
+		want, _ := id.From(e)
+		for j := 0; j < 200; j++ {
+			got, _ := id.From(e)
+			if e.Signal() != sig || got != want {
+				t.Errorf("replayed event changed during delivery: %s id=%d, want %s id=%d",
+					e.Signal().Name(), got, sig.Name(), want)
+				return
+			}
+			time.Sleep(10 * time.Microsecond)
+		}
+	})
+
+	close(stop)
+	wg.Wait()
+	if replayed != 4 {
+		t.Errorf("expected 4 replayed events, got %d", replayed)
+	}
+}
@@ -0,0 +1,34 @@
+package capitan
+
+import "context"
+
+// EmitInterceptor inspects an emission before its event is built. Returning
+// false vetoes the emission. It must not modify fields.
+type EmitInterceptor func(ctx context.Context, signal Signal, severity Severity, fields []Field) (allow bool)
+
+// WithEmitInterceptor registers fn to run on the emitting goroutine for every
+// emit, for counting, tracing, or vetoing emissions without touching call
+// sites. It sees the fields as passed to Emit, before enrichers and pre-emit
+// hooks. Interceptors run in registration order; the first to return false
+// drops the emission with DropReasonIntercepted and the rest are skipped.
+func WithEmitInterceptor(fn EmitInterceptor) Option {
+	return func(c *Capitan) {
+		if fn == nil {
+			c.invalid("WithEmitInterceptor: interceptor is nil")
+			return
+		}
+		c.interceptors = append(c.interceptors, fn)
+	}
+}
+
+// intercepted reports whether an interceptor vetoed the emission, recording
+// the drop if so.
+func (c *Capitan) intercepted(ctx context.Context, signal Signal, severity Severity, fields []Field) bool {
+	for _, fn := range c.interceptors {
+		if !fn(ctx, signal, severity, fields) {
+			c.reportDrop(signal, DropReasonIntercepted)
+			return true
+		}
+	}
+	return false
+}
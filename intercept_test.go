@@ -0,0 +1,90 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWithEmitInterceptorVeto(t *testing.T) {
+	blocked := NewSignal("test.intercept.blocked", "Test blocked signal")
+	allowed := NewSignal("test.intercept.allowed", "Test allowed signal")
+
+	var second int
+	c := New(
+		WithSyncMode(),
+		WithEmitInterceptor(func(_ context.Context, signal Signal, _ Severity, _ []Field) bool {
+			return signal != blocked
+		}),
+		WithEmitInterceptor(func(context.Context, Signal, Severity, []Field) bool {
+			second++
+			return true
+		}),
+	)
+	defer c.Shutdown()
+
+	var delivered []Signal
+	record := func(_ context.Context, e *Event) { delivered = append(delivered, e.Signal()) }
+	c.Hook(blocked, record)
+	c.Hook(allowed, record)
+
+	c.Emit(context.Background(), blocked)
+	c.Emit(context.Background(), allowed)
+	c.EmitBatch(context.Background(), blocked, [][]Field{nil, nil})
+
+	if len(delivered) != 1 || delivered[0] != allowed {
+		t.Errorf("expected only %s delivered, got %v", allowed.Name(), delivered)
+	}
+	if second != 1 {
+		t.Errorf("expected a veto to skip later interceptors, got %d calls", second)
+	}
+	if n := c.Stats().DropCounts[DropReasonIntercepted]; n != 3 {
+		t.Errorf("expected 3 intercepted drops, got %d", n)
+	}
+}
+
+func TestWithEmitInterceptorSeverities(t *testing.T) {
+	counts := make(map[Severity]int)
+	var fieldCounts []int
+	c := New(
+		WithSyncMode(),
+		WithEmitInterceptor(func(_ context.Context, _ Signal, severity Severity, fields []Field) bool {
+			counts[severity]++
+			fieldCounts = append(fieldCounts, len(fields))
+			return true
+		}),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.intercept.count", "Test interceptor count signal")
+	key := NewStringKey("k")
+	var delivered int
+	c.Hook(sig, func(context.Context, *Event) { delivered++ })
+
+	ctx := context.Background()
+	c.Debug(ctx, sig)
+	c.Info(ctx, sig, key.Field("v"))
+	c.Warn(ctx, sig)
+	c.Error(ctx, sig)
+	c.Emit(ctx, sig)
+	c.EmitSeverity(ctx, sig, "TRACE")
+
+	want := map[Severity]int{SeverityDebug: 1, SeverityInfo: 2, SeverityWarn: 1, SeverityError: 1, "TRACE": 1}
+	for severity, n := range want {
+		if counts[severity] != n {
+			t.Errorf("expected %d %s emits seen, got %d", n, severity, counts[severity])
+		}
+	}
+	if fieldCounts[1] != 1 {
+		t.Errorf("expected interceptor to see emitted fields, got %v", fieldCounts)
+	}
+	if delivered != 6 {
+		t.Errorf("expected every allowed emit delivered, got %d", delivered)
+	}
+}
+
+func TestWithEmitInterceptorNil(t *testing.T) {
+	if _, err := NewValidated(WithEmitInterceptor(nil)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected nil interceptor to be reported as invalid, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package capitan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// JoinElapsedKey is the field Join adds to combined events, holding the
+// time between the two matched events' timestamps.
+var JoinElapsedKey = NewDurationKey("join_elapsed")
+
+// joinEntry is an event waiting for its partner on the other signal.
+type joinEntry struct {
+	signal    Signal
+	timestamp time.Time
+	severity  Severity
+	fields    []Field
+	timer     ClockTimer
+}
+
+// joiner pairs events from two signals by correlation value.
+type joiner struct {
+	c      *Capitan
+	a, b   Signal
+	key    Key
+	window time.Duration
+	out    Signal
+
+	mu      sync.Mutex
+	pending map[string]*joinEntry
+	stopped bool
+}
+
+// Join pairs events on signals a and b that carry the same value for key,
+// emitting one combined event on out per match. The combined event holds a's
+// fields followed by b's (b wins on name clashes) plus a JoinElapsedKey field
+// with b's timestamp minus a's, so arrival order doesn't matter. It uses the
+// more severe of the two severities and the context of the event that
+// completed the match.
+//
+// Unmatched events wait at most window; an expired event, or one superseded
+// by a newer event on the same signal with the same value, is reported to the
+// drop handler with DropReasonExpired. Memory is bounded by the number of
+// correlation values active within the window. Events without key are
+// ignored. Call stop to detach both listeners and discard pending events;
+// shutting down c discards them too.
+func Join(c *Capitan, a, b Signal, key Key, window time.Duration, out Signal) (stop func()) {
+	j := &joiner{
+		c:       c,
+		a:       a,
+		b:       b,
+		key:     key,
+		window:  window,
+		out:     out,
+		pending: make(map[string]*joinEntry),
+	}
+	la := c.hookInternal(a, j.receive)
+	lb := c.hookInternal(b, j.receive)
+
+	// Pending timers must not outlive the instance
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-c.shutdown:
+			j.stop()
+		case <-done:
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			la.Close()
+			lb.Close()
+			close(done)
+			j.stop()
+		})
+	}
+}
+
+// receive matches an event against its pending partner or buffers it.
+func (j *joiner) receive(ctx context.Context, e *Event) {
+	field := e.Get(j.key)
+	if field == nil {
+		return
+	}
+	value := fmt.Sprint(field.Value())
+
+	j.mu.Lock()
+	if j.stopped {
+		j.mu.Unlock()
+		return
+	}
+	entry, found := j.pending[value]
+	if found && entry.signal != e.Signal() {
+		entry.timer.Stop()
+		delete(j.pending, value)
+		j.mu.Unlock()
+		j.emit(ctx, entry, e)
+		return
+	}
+	if found {
+		// Same signal again: the newer event replaces the older
+		entry.timer.Stop()
+	}
+	next := &joinEntry{
+		signal:    e.Signal(),
+		timestamp: e.Timestamp(),
+		severity:  e.Severity(),
+		fields:    e.Fields(),
+	}
+	next.timer = j.c.timers.AfterFunc(j.window, func() { j.expire(value, next) })
+	j.pending[value] = next
+	j.mu.Unlock()
+
+	if found {
+		j.c.reportDrop(entry.signal, DropReasonExpired)
+	}
+}
+
+// emit combines a pending entry with the event that matched it.
+func (j *joiner) emit(ctx context.Context, entry *joinEntry, e *Event) {
+	first, second := entry.fields, e.Fields()
+	elapsed := e.Timestamp().Sub(entry.timestamp)
+	if entry.signal == j.b {
+		first, second = second, first
+		elapsed = -elapsed
+	}
+
+	severity := e.Severity()
+	if j.c.severityAtLeast(entry.severity, severity) {
+		severity = entry.severity
+	}
+
+	fields := make([]Field, 0, len(first)+len(second)+1)
+	fields = append(fields, first...)
+	fields = append(fields, second...)
+	fields = append(fields, JoinElapsedKey.Field(elapsed))
+	j.c.EmitSeverity(ctx, j.out, severity, fields...)
+}
+
+// expire drops entry if it is still waiting when its window ends.
+func (j *joiner) expire(value string, entry *joinEntry) {
+	j.mu.Lock()
+	if j.pending[value] != entry || j.c.closed.Load() {
+		j.mu.Unlock()
+		return
+	}
+	delete(j.pending, value)
+	j.mu.Unlock()
+	j.c.reportDrop(entry.signal, DropReasonExpired)
+}
+
+// stop discards pending entries without reporting them.
+func (j *joiner) stop() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.stopped = true
+	for value, entry := range j.pending {
+		entry.timer.Stop()
+		delete(j.pending, value)
+	}
+}
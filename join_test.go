@@ -0,0 +1,118 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestJoin verifies matched pairs emit one combined event regardless of arrival order.
+func TestJoin(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	started := NewSignal("test.join.started", "Test join started signal")
+	finished := NewSignal("test.join.finished", "Test join finished signal")
+	completed := NewSignal("test.join.completed", "Test join completed signal")
+	requestID := NewStringKey("request_id")
+	path := NewStringKey("path")
+	status := NewIntKey("status")
+
+	var got []map[string]any
+	var severities []Severity
+	c.Hook(completed, func(_ context.Context, e *Event) {
+		got = append(got, e.FieldsMap())
+		severities = append(severities, e.Severity())
+	})
+
+	stop := Join(c, started, finished, requestID, time.Minute, completed)
+	defer stop()
+
+	ctx := context.Background()
+	base := time.Now()
+	emitAt := func(sig Signal, sev Severity, at time.Time, fields ...Field) {
+		e := c.newEvent(ctx, sig, sev, at, fields...)
+		c.processEvent(sig, e)
+	}
+
+	// In order
+	emitAt(started, SeverityInfo, base, requestID.Field("r1"), path.Field("/a"))
+	emitAt(finished, SeverityInfo, base.Add(30*time.Millisecond), requestID.Field("r1"), status.Field(200))
+
+	// Out of order: finished delivered before started
+	emitAt(finished, SeverityError, base.Add(50*time.Millisecond), requestID.Field("r2"), status.Field(500))
+	emitAt(started, SeverityInfo, base.Add(10*time.Millisecond), requestID.Field("r2"), path.Field("/b"))
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 joined events, got %d", len(got))
+	}
+	if got[0]["path"] != "/a" || got[0]["status"] != 200 || got[0]["join_elapsed"] != 30*time.Millisecond {
+		t.Errorf("unexpected first join: %v", got[0])
+	}
+	if got[1]["path"] != "/b" || got[1]["status"] != 500 || got[1]["join_elapsed"] != 40*time.Millisecond {
+		t.Errorf("unexpected out-of-order join: %v", got[1])
+	}
+	if severities[1] != SeverityError {
+		t.Errorf("expected the more severe severity, got %s", severities[1])
+	}
+}
+
+// TestJoinExpiry verifies unmatched events are dropped after the window.
+func TestJoinExpiry(t *testing.T) {
+	var mu sync.Mutex
+	var drops []DropReason
+	c := New(WithSyncMode(), WithDropHandler(func(_ Signal, reason DropReason) {
+		mu.Lock()
+		drops = append(drops, reason)
+		mu.Unlock()
+	}))
+	defer c.Shutdown()
+
+	started := NewSignal("test.join.expiry.started", "Test join expiry started signal")
+	finished := NewSignal("test.join.expiry.finished", "Test join expiry finished signal")
+	completed := NewSignal("test.join.expiry.completed", "Test join expiry completed signal")
+	requestID := NewStringKey("request_id")
+
+	joined := 0
+	c.Hook(completed, func(_ context.Context, _ *Event) { joined++ })
+
+	stop := Join(c, started, finished, requestID, 20*time.Millisecond, completed)
+	defer stop()
+
+	ctx := context.Background()
+	c.Emit(ctx, started, requestID.Field("late"))
+	time.Sleep(60 * time.Millisecond)
+	c.Emit(ctx, finished, requestID.Field("late"))
+
+	if joined != 0 {
+		t.Errorf("expected no join after expiry, got %d", joined)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(drops) != 1 || drops[0] != DropReasonExpired {
+		t.Errorf("expected one expired drop, got %v", drops)
+	}
+}
+
+func TestJoinShutdownStopsTimers(t *testing.T) {
+	var drops atomic.Int32
+	c := New(WithSyncMode(), WithDropHandler(func(Signal, DropReason) { drops.Add(1) }))
+
+	started := NewSignal("test.join.shutdown.started", "Test join shutdown started signal")
+	finished := NewSignal("test.join.shutdown.finished", "Test join shutdown finished signal")
+	completed := NewSignal("test.join.shutdown.completed", "Test join shutdown completed signal")
+	requestID := NewStringKey("request_id")
+
+	stop := Join(c, started, finished, requestID, 20*time.Millisecond, completed)
+	defer stop()
+
+	c.Emit(context.Background(), started, requestID.Field("pending"))
+	c.Shutdown()
+	time.Sleep(60 * time.Millisecond)
+
+	if got := drops.Load(); got != 0 {
+		t.Errorf("expected pending entry discarded on shutdown, got %d drops", got)
+	}
+}
@@ -0,0 +1,72 @@
+package capitan
+
+// KeyEqual reports whether two keys have the same name and variant.
+// Keys of different concrete types are equal if both match.
+func KeyEqual(a, b Key) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Name() == b.Name() && a.Variant() == b.Variant()
+}
+
+// keyID is the comparable identity of a Key.
+type keyID struct {
+	name    string
+	variant Variant
+}
+
+func idOf(k Key) keyID {
+	return keyID{name: k.Name(), variant: k.Variant()}
+}
+
+// KeySet is an insertion-ordered set of keys, deduplicated by KeyEqual.
+// The zero value is an empty set ready to use. Not safe for concurrent use.
+type KeySet struct {
+	index map[keyID]struct{}
+	keys  []Key
+}
+
+// NewKeySet creates a KeySet containing the given keys.
+func NewKeySet(keys ...Key) *KeySet {
+	s := &KeySet{}
+	for _, k := range keys {
+		s.Add(k)
+	}
+	return s
+}
+
+// Add inserts k unless an equal key is already present.
+// Returns true if k was added. Nil keys are ignored.
+func (s *KeySet) Add(k Key) bool {
+	if k == nil || s.Has(k) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[keyID]struct{})
+	}
+	s.index[idOf(k)] = struct{}{}
+	s.keys = append(s.keys, k)
+	return true
+}
+
+// Has reports whether the set contains a key equal to k.
+func (s *KeySet) Has(k Key) bool {
+	if k == nil {
+		return false
+	}
+	_, ok := s.index[idOf(k)]
+	return ok
+}
+
+// Len returns the number of keys in the set.
+func (s *KeySet) Len() int {
+	return len(s.keys)
+}
+
+// Slice returns the keys in insertion order.
+// The returned slice is a copy and safe to modify.
+func (s *KeySet) Slice() []Key {
+	keys := make([]Key, len(s.keys))
+	copy(keys, s.keys)
+	return keys
+}
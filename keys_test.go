@@ -0,0 +1,75 @@
+package capitan
+
+import "testing"
+
+// otherStringKey is a distinct Key implementation used to check cross-type equality.
+type otherStringKey struct{ name string }
+
+func (k otherStringKey) Name() string     { return k.name }
+func (k otherStringKey) Variant() Variant { return VariantString }
+
+func TestKeyEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Key
+		want bool
+	}{
+		{"same", NewStringKey("id"), NewStringKey("id"), true},
+		{"different name", NewStringKey("id"), NewStringKey("name"), false},
+		{"different variant", NewStringKey("id"), NewIntKey("id"), false},
+		{"different type same identity", NewStringKey("id"), otherStringKey{"id"}, true},
+		{"one nil", NewStringKey("id"), nil, false},
+		{"both nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KeyEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("KeyEqual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeySet(t *testing.T) {
+	var s KeySet
+
+	if s.Has(NewStringKey("id")) {
+		t.Error("zero value set should be empty")
+	}
+	if !s.Add(NewStringKey("id")) {
+		t.Error("expected first Add to succeed")
+	}
+	if s.Add(otherStringKey{"id"}) {
+		t.Error("expected equal key to be rejected")
+	}
+	if s.Add(nil) {
+		t.Error("expected nil key to be ignored")
+	}
+	s.Add(NewIntKey("id"))
+	s.Add(NewStringKey("name"))
+
+	if s.Len() != 3 {
+		t.Fatalf("expected 3 keys, got %d", s.Len())
+	}
+
+	keys := s.Slice()
+	want := []string{"id", "id", "name"}
+	for i, k := range keys {
+		if k.Name() != want[i] {
+			t.Errorf("key %d: expected %q, got %q", i, want[i], k.Name())
+		}
+	}
+
+	keys[0] = nil
+	if s.Slice()[0] == nil {
+		t.Error("Slice should return a copy")
+	}
+}
+
+func TestNewKeySet(t *testing.T) {
+	s := NewKeySet(NewStringKey("a"), NewStringKey("a"), NewStringKey("b"))
+	if s.Len() != 2 || !s.Has(NewStringKey("b")) {
+		t.Errorf("expected deduplicated set of 2, got %v", s.Slice())
+	}
+}
@@ -0,0 +1,54 @@
+package capitan
+
+import "sync"
+
+// LazyField creates a field whose value is computed by fn the first time it
+// is read, rather than at emit time. Use it for expensive values (payload
+// sizes, stack summaries) that are wasted when the event is dropped or
+// filtered by severity. fn runs at most once, on whichever consumer reads the
+// field first through Value, From, Walk, or any rendering; later reads share
+// the result. Field limits don't evaluate lazy fields.
+func LazyField[T any](key GenericKey[T], fn func() T) Field {
+	return lazyField[T]{key: key, state: &lazyState[T]{fn: fn}}
+}
+
+// lazyState holds the once-computed value shared by copies of a lazyField.
+type lazyState[T any] struct {
+	once  sync.Once
+	fn    func() T
+	value T
+}
+
+// lazyField is a Field computed on first read.
+type lazyField[T any] struct {
+	key   GenericKey[T]
+	state *lazyState[T]
+}
+
+// Variant returns the key's variant.
+func (f lazyField[T]) Variant() Variant { return f.key.variant }
+
+// Key returns the field's key.
+func (f lazyField[T]) Key() Key { return f.key }
+
+// Value evaluates the field if needed and returns its value as any.
+func (f lazyField[T]) Value() any { return f.Get() }
+
+// Get evaluates the field if needed and returns its typed value.
+func (f lazyField[T]) Get() T {
+	f.state.once.Do(func() {
+		f.state.value = f.state.fn()
+		f.state.fn = nil
+	})
+	return f.state.value
+}
+
+// resolve evaluates the field and returns it as a plain GenericField.
+func (f lazyField[T]) resolve() Field {
+	return f.key.Field(f.Get())
+}
+
+// resolver is implemented by fields that stand in for a GenericField.
+type resolver interface {
+	resolve() Field
+}
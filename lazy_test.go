@@ -0,0 +1,77 @@
+package capitan
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestLazyFieldSkippedWhenFiltered verifies no evaluation for events below the minimum severity.
+func TestLazyFieldSkippedWhenFiltered(t *testing.T) {
+	c := New(WithSyncMode(), WithMinSeverity(SeverityWarn))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.lazy.filtered", "Test lazy filtered signal")
+	size := NewIntKey("size")
+	c.Hook(sig, func(_ context.Context, e *Event) { size.From(e) })
+
+	var evaluations atomic.Int32
+	c.Debug(context.Background(), sig, LazyField(size, func() int {
+		evaluations.Add(1)
+		return 42
+	}))
+
+	if n := evaluations.Load(); n != 0 {
+		t.Errorf("expected no evaluation for a filtered event, got %d", n)
+	}
+}
+
+// TestLazyFieldEvaluatedOnce verifies concurrent readers share a single evaluation.
+func TestLazyFieldEvaluatedOnce(t *testing.T) {
+	sig := NewSignal("test.lazy.once", "Test lazy once signal")
+	c := New(WithSyncMode(), WithConcurrentListeners(sig, 2))
+	defer c.Shutdown()
+
+	size := NewIntKey("size")
+	var mu sync.Mutex
+	var got []int
+	for i := 0; i < 2; i++ {
+		c.Hook(sig, func(_ context.Context, e *Event) {
+			v, ok := size.From(e)
+			if !ok {
+				t.Error("expected lazy field to be found by From")
+			}
+			mu.Lock()
+			got = append(got, v)
+			mu.Unlock()
+		})
+	}
+
+	var evaluations atomic.Int32
+	c.Emit(context.Background(), sig, LazyField(size, func() int {
+		evaluations.Add(1)
+		return 42
+	}))
+
+	if n := evaluations.Load(); n != 1 {
+		t.Errorf("expected exactly one evaluation, got %d", n)
+	}
+	if len(got) != 2 || got[0] != 42 || got[1] != 42 {
+		t.Errorf("expected both listeners to read 42, got %v", got)
+	}
+}
+
+// TestLazyFieldVisited verifies visitors see the resolved typed value.
+func TestLazyFieldVisited(t *testing.T) {
+	name := NewStringKey("name")
+	f := LazyField(name, func() string { return "lazy" })
+
+	if got := FieldToSlogAttr(f); got.Value.Kind() != slog.KindString || got.Value.String() != "lazy" {
+		t.Errorf("expected string attr, got %v", got)
+	}
+	if f.Value() != "lazy" || f.Key().Name() != "name" || f.Variant() != VariantString {
+		t.Errorf("unexpected field accessors: %v %v %v", f.Value(), f.Key().Name(), f.Variant())
+	}
+}
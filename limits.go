@@ -0,0 +1,94 @@
+package capitan
+
+import "fmt"
+
+// checkLimits validates fields against the configured field count and byte size limits.
+// Returns nil when no limit is configured or none is exceeded.
+func (c *Capitan) checkLimits(fields []Field) error {
+	if c.maxFields > 0 && len(fields) > c.maxFields {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyFields, len(fields), c.maxFields)
+	}
+	if c.maxBytesSize > 0 {
+		if size := bytesFieldSize(fields); size > c.maxBytesSize {
+			return fmt.Errorf("%w: %d > %d bytes", ErrFieldsTooLarge, size, c.maxBytesSize)
+		}
+	}
+	return nil
+}
+
+// bytesFieldSize sums the lengths of all []byte-variant fields.
+func bytesFieldSize(fields []Field) int {
+	total := 0
+	for _, f := range fields {
+		if f == nil || f.Variant() != VariantBytes {
+			continue
+		}
+		// Avoid boxing the slice through Value() for the built-in field type
+		if bf, ok := f.(GenericField[[]byte]); ok {
+			total += len(bf.value)
+		} else if b, ok := f.Value().([]byte); ok {
+			total += len(b)
+		}
+	}
+	return total
+}
+
+// reject drops an event that failed emit-time checks before it was created,
+// counting it as a drop and reporting err to the error handler.
+func (c *Capitan) reject(signal Signal, severity Severity, fields []Field, reason DropReason, err error) {
+	c.reportDrop(signal, reason)
+	if c.dlq != nil {
+		c.dlq.push(DeadEvent{
+			Signal:   signal,
+			Severity: severity,
+			Fields:   append([]Field(nil), fields...),
+			Reason:   reason,
+			Err:      err,
+			Time:     c.clock(),
+		})
+	}
+	if c.errorHandler != nil {
+		c.errorHandler(signal, err)
+	}
+}
+
+// limitsEnabled reports whether any emit-time field limit is configured.
+func (c *Capitan) limitsEnabled() bool {
+	return c.maxFields > 0 || c.maxBytesSize > 0
+}
+
+// checkDuplicates returns an error naming the first field name that appears
+// more than once.
+func checkDuplicates(fields []Field) error {
+	seen := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		if f == nil || f.Key() == nil {
+			continue
+		}
+		name := f.Key().Name()
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateField, name)
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
+
+// collectDuplicates groups fields by name, keeping only names that appear
+// more than once.
+func collectDuplicates(fields []Field) map[string][]Field {
+	byName := make(map[string][]Field, len(fields))
+	for _, f := range fields {
+		if f == nil || f.Key() == nil {
+			continue
+		}
+		name := f.Key().Name()
+		byName[name] = append(byName[name], f)
+	}
+	for name, all := range byName {
+		if len(all) < 2 {
+			delete(byName, name)
+		}
+	}
+	return byName
+}
@@ -0,0 +1,163 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWithMaxFields(t *testing.T) {
+	var errs []error
+	var drops []DropReason
+	c := New(
+		WithSyncMode(),
+		WithMaxFields(2),
+		WithErrorHandler(func(_ Signal, err error) { errs = append(errs, err) }),
+		WithDropHandler(func(_ Signal, reason DropReason) { drops = append(drops, reason) }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.limits.fields", "Test field limit signal")
+	key := NewIntKey("n")
+	received := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { received++ })
+
+	c.Emit(context.Background(), sig, key.Field(1), key.Field(2))
+	c.Emit(context.Background(), sig, key.Field(1), key.Field(2), key.Field(3))
+
+	if received != 1 {
+		t.Errorf("expected only the event within limits delivered, got %d", received)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrTooManyFields) {
+		t.Errorf("expected ErrTooManyFields, got %v", errs)
+	}
+	if len(drops) != 1 || drops[0] != DropReasonLimit {
+		t.Errorf("expected one limit drop, got %v", drops)
+	}
+
+	stats := c.Stats()
+	if stats.EmitCounts[sig] != 1 || stats.DropCounts[DropReasonLimit] != 1 {
+		t.Errorf("expected 1 emit and 1 limit drop, got %d/%d", stats.EmitCounts[sig], stats.DropCounts[DropReasonLimit])
+	}
+}
+
+func TestWithMaxBytesFieldSize(t *testing.T) {
+	var errs []error
+	c := New(
+		WithSyncMode(),
+		WithMaxBytesFieldSize(8),
+		WithErrorHandler(func(_ Signal, err error) { errs = append(errs, err) }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.limits.bytes", "Test byte limit signal")
+	a, b := NewBytesKey("a"), NewBytesKey("b")
+	received := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { received++ })
+
+	// Strings don't count toward the byte limit
+	c.Emit(context.Background(), sig, a.Field(make([]byte, 4)), b.Field(make([]byte, 4)), NewStringKey("s").Field("0123456789"))
+	// Sum across fields exceeds the limit
+	c.Emit(context.Background(), sig, a.Field(make([]byte, 4)), b.Field(make([]byte, 5)))
+
+	if received != 1 {
+		t.Errorf("expected only the event within limits delivered, got %d", received)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrFieldsTooLarge) {
+		t.Errorf("expected ErrFieldsTooLarge, got %v", errs)
+	}
+}
+
+func TestEmitBatchLimits(t *testing.T) {
+	c := New(WithSyncMode(), WithMaxFields(1))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.limits.batch", "Test batch limit signal")
+	key := NewIntKey("n")
+	received := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { received++ })
+
+	c.EmitBatch(context.Background(), sig, [][]Field{
+		{key.Field(1)},
+		{key.Field(1), key.Field(2)},
+		{key.Field(3)},
+	})
+
+	if received != 2 {
+		t.Errorf("expected 2 events delivered, got %d", received)
+	}
+	if got := c.Stats().DropCounts[DropReasonLimit]; got != 1 {
+		t.Errorf("expected 1 limit drop, got %d", got)
+	}
+}
+
+func TestDuplicateFieldPolicyError(t *testing.T) {
+	var errs []error
+	var reasons []DropReason
+	c := New(
+		WithSyncMode(),
+		WithDuplicateFieldPolicy(DupError),
+		WithErrorHandler(func(_ Signal, err error) { errs = append(errs, err) }),
+		WithDropHandler(func(_ Signal, reason DropReason) { reasons = append(reasons, reason) }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.dup.error", "Test duplicate error signal")
+	tag := NewStringKey("tag")
+	delivered := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { delivered++ })
+
+	if err := c.EmitChecked(context.Background(), sig, tag.Field("a"), tag.Field("b")); !errors.Is(err, ErrDuplicateField) {
+		t.Errorf("expected ErrDuplicateField, got %v", err)
+	}
+	c.EmitBatch(context.Background(), sig, [][]Field{{tag.Field("a"), tag.Field("b")}, {tag.Field("c")}})
+
+	if delivered != 1 {
+		t.Errorf("expected only the unique event delivered, got %d", delivered)
+	}
+	if len(errs) != 2 || len(reasons) != 2 || reasons[0] != DropReasonDuplicate {
+		t.Errorf("expected two duplicate rejections, got %v %v", errs, reasons)
+	}
+}
+
+func TestDuplicateFieldPolicyKeepAll(t *testing.T) {
+	for _, tc := range []struct {
+		policy DuplicateFieldPolicy
+		want   []string
+	}{
+		{DupKeepLast, []string{"b"}},
+		{DupKeepAll, []string{"a", "b"}},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			c := New(WithSyncMode(), WithDuplicateFieldPolicy(tc.policy))
+			defer c.Shutdown()
+
+			sig := NewSignal("test.dup.keep", "Test duplicate keep signal")
+			tag := NewStringKey("tag")
+			var all []string
+			var last string
+			c.Hook(sig, func(_ context.Context, e *Event) {
+				for _, f := range e.GetAll("tag") {
+					all = append(all, f.Value().(string))
+				}
+				last, _ = tag.From(e)
+				if e.GetAll("missing") != nil {
+					t.Error("expected nil for missing name")
+				}
+			})
+			c.Emit(context.Background(), sig, tag.Field("a"), tag.Field("b"))
+
+			if len(all) != len(tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, all)
+			}
+			for i := range all {
+				if all[i] != tc.want[i] {
+					t.Errorf("expected %v, got %v", tc.want, all)
+				}
+			}
+			if last != "b" {
+				t.Errorf("expected From to return the last field, got %q", last)
+			}
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package capitan
 
-import "context"
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
 
 // EventCallback is a function that handles an Event.
 // The context is inherited from the Emit call and can be used for cancellation,
@@ -9,15 +15,138 @@ import "context"
 // Events must not be modified by listeners.
 type EventCallback func(context.Context, *Event)
 
+// EventHandler is an EventCallback that reports failure by returning an error.
+// Failed invocations are handled according to the instance's ErrorPolicy.
+type EventHandler func(context.Context, *Event) error
+
 // Listener represents an active subscription to a signal.
 // Call Close() to unregister the listener and prevent further callbacks.
 type Listener struct {
 	signal   Signal
 	callback EventCallback
+	handler  EventHandler // set instead of callback for HookE listeners
 	capitan  *Capitan
+	observer *Observer // non-nil when created by an Observer
+	name     string    // optional; reported in diagnostics
+
+	// internal marks listeners hooked by helpers such as Route, Join, and
+	// Events; they don't count against HookExclusive.
+	internal bool
+
+	// retryPolicy overrides the instance ErrorPolicy for this listener's errors.
+	retryPolicy *RetryPolicy
+
+	// queue is set for HookBuffered listeners delivered on their own goroutine.
+	queue *listenerQueue
+
+	// active is true while the listener is in its Capitan's registry.
+	// Written under the Capitan's write lock; read without locking.
+	active atomic.Bool
+
+	// done is set for HookCtx listeners and closed by Close, releasing the
+	// goroutine that waits on the context.
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// ready is set for HookReplay listeners; live delivery waits until it is
+	// closed, after the history has been replayed.
+	ready chan struct{}
+
+	// replaced holds the callback installed by SetCallback, which takes
+	// precedence over callback and handler.
+	replaced atomic.Pointer[EventCallback]
 }
 
 // Close removes this listener from the registry, preventing future callbacks.
 func (l *Listener) Close() {
 	l.capitan.unregister(l)
+	l.release()
+}
+
+// release stops the listener's delivery goroutine and context watcher, if any.
+// Called after the listener has left the registry.
+func (l *Listener) release() {
+	if l.queue != nil {
+		l.queue.stop()
+	}
+	if l.done != nil {
+		l.closeOnce.Do(func() { close(l.done) })
+	}
+}
+
+// released reports whether release has stopped the listener's delivery
+// goroutine or context watcher, which can't be restarted.
+func (l *Listener) released() bool {
+	if l.queue != nil {
+		select {
+		case <-l.queue.done:
+			return true
+		default:
+		}
+	}
+	if l.done != nil {
+		select {
+		case <-l.done:
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+// Name returns the name given to HookNamed, or "" for unnamed listeners.
+func (l *Listener) Name() string {
+	return l.name
+}
+
+// Active reports whether the listener is still registered: true from Hook
+// until Close, or until its observer or Capitan is closed.
+func (l *Listener) Active() bool {
+	return l.active.Load()
+}
+
+// SetCallback atomically replaces the listener's callback, for hot-reloading
+// handler logic without unregistering. Events already being delivered finish
+// with the old callback; every later delivery, including events already
+// queued, uses the new one. On a HookE or HookWithRetry listener the callback
+// replaces the handler, so its deliveries no longer report errors.
+// A nil callback is ignored.
+func (l *Listener) SetCallback(callback EventCallback) {
+	if callback != nil {
+		l.replaced.Store(&callback)
+	}
+}
+
+// invoke calls the listener's current callback or error-returning handler.
+func (l *Listener) invoke(ctx context.Context, e *Event) error {
+	if l.observer != nil && l.observer.paused.Load() {
+		return nil
+	}
+	if replaced := l.replaced.Load(); replaced != nil {
+		(*replaced)(ctx, e)
+		return nil
+	}
+	if l.handler != nil {
+		return l.handler(ctx, e)
+	}
+	l.callback(ctx, e)
+	return nil
+}
+
+// label returns the listener's name, falling back to the name of its function.
+// Only used on diagnostic paths, as resolving the function name is not free.
+func (l *Listener) label() string {
+	if l.name != "" {
+		return l.name
+	}
+	var fn any = l.callback
+	if replaced := l.replaced.Load(); replaced != nil {
+		fn = *replaced
+	} else if l.handler != nil {
+		fn = l.handler
+	}
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
 }
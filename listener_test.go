@@ -2,7 +2,9 @@ package capitan
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -341,3 +343,454 @@ func TestEmitToClosedWorkerDropsEvent(t *testing.T) {
 		t.Errorf("expected 1 event received, got %d", finalCount)
 	}
 }
+
+func TestHookExclusive(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.exclusive", "Test exclusive signal")
+
+	first, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) {})
+	if err != nil {
+		t.Fatalf("first registration failed: %v", err)
+	}
+
+	second, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) {})
+	if !errors.Is(err, ErrListenerExists) {
+		t.Errorf("expected ErrListenerExists, got %v", err)
+	}
+	if second != nil {
+		t.Error("expected nil listener on duplicate registration")
+	}
+
+	// Closing the first listener frees the slot
+	first.Close()
+	if _, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) {}); err != nil {
+		t.Errorf("expected registration after close to succeed, got %v", err)
+	}
+}
+
+func TestHookExclusiveAfterPlainHook(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.exclusive.plain", "Test exclusive after hook signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+
+	if _, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) {}); !errors.Is(err, ErrListenerExists) {
+		t.Errorf("expected ErrListenerExists, got %v", err)
+	}
+}
+
+func TestHookExclusiveIgnoresHelpers(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.exclusive.helpers", "Test exclusive helpers signal")
+	other := NewSignal("test.exclusive.helpers.other", "Test exclusive helpers other signal")
+	out := NewSignal("test.exclusive.helpers.out", "Test exclusive helpers out signal")
+
+	stops := []func(){
+		Route(c, sig, out, func(e *Event) ([]Field, bool) { return e.Fields(), true }),
+		FanIn(c, out, sig),
+		Join(c, sig, other, NewStringKey("id"), time.Second, out),
+		Aggregate(c, sig, NewFloat64Key("v"), time.Second, out),
+		PublishTo(c, &memoryPublisher{}, JSONEncoder{}, func(s Signal) string { return s.Name() }, sig),
+	}
+	defer func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}()
+
+	if _, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) {}); err != nil {
+		t.Fatalf("expected helper listeners not to count against exclusivity, got %v", err)
+	}
+}
+
+func TestHookExclusiveIgnoresObservers(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.exclusive.observer", "Test exclusive observer signal")
+
+	c.Observe(func(_ context.Context, _ *Event) {})
+	// Create the signal so the observer attaches to it
+	c.Emit(context.Background(), sig)
+
+	calls := 0
+	if _, err := c.HookExclusive(sig, func(_ context.Context, _ *Event) { calls++ }); err != nil {
+		t.Fatalf("expected observers not to count against exclusivity, got %v", err)
+	}
+
+	c.Emit(context.Background(), sig)
+	if calls != 1 {
+		t.Errorf("expected exclusive listener to be called once, got %d", calls)
+	}
+}
+
+func TestListenerActive(t *testing.T) {
+	c := New()
+
+	sig := NewSignal("test.listener.active", "Test listener active signal")
+	other := NewSignal("test.listener.active.other", "Test listener active other signal")
+	first := c.Hook(sig, func(_ context.Context, _ *Event) {})
+	second := c.Hook(sig, func(_ context.Context, _ *Event) {})
+	third := c.Hook(other, func(_ context.Context, _ *Event) {})
+
+	if !first.Active() || !second.Active() {
+		t.Fatal("expected hooked listeners to be active")
+	}
+
+	first.Close()
+	first.Close()
+	if first.Active() {
+		t.Error("expected closed listener to be inactive")
+	}
+	if !second.Active() {
+		t.Error("expected sibling listener to stay active after swap-removal")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			second.Close()
+			_ = second.Active()
+		}()
+	}
+	wg.Wait()
+	if second.Active() {
+		t.Error("expected concurrently closed listener to be inactive")
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	if third.Active() {
+		t.Error("expected listeners inactive after the instance is closed")
+	}
+}
+
+func TestObserverListenerActive(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.observer.active", "Test observer active signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	obs := c.Observe(func(_ context.Context, _ *Event) {})
+
+	obs.mu.Lock()
+	listeners := append([]*Listener(nil), obs.listeners...)
+	obs.mu.Unlock()
+	if len(listeners) == 0 || !listeners[0].Active() {
+		t.Fatal("expected observer listener to be active")
+	}
+
+	obs.Close()
+	for _, l := range listeners {
+		if l.Active() {
+			t.Error("expected observer listeners inactive after Close")
+		}
+	}
+}
+
+func TestObserveChan(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.observe.chan", "Test observe chan signal")
+	other := NewSignal("test.observe.chan.other", "Test observe chan other signal")
+	id := NewIntKey("id")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	c.Hook(other, func(_ context.Context, _ *Event) {})
+
+	events, obs := c.ObserveChan(10, sig)
+	for i := 0; i < 3; i++ {
+		c.Emit(context.Background(), sig, id.Field(i))
+		c.Emit(context.Background(), other, id.Field(i))
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	obs.Close()
+	obs.Close()
+
+	var got []int
+	for e := range events {
+		if e.Signal() != sig {
+			t.Errorf("expected only whitelisted signal, got %s", e.Signal().Name())
+		}
+		v, _ := id.From(e)
+		got = append(got, v)
+	}
+	if len(got) != 3 || got[0] != 0 || got[2] != 2 {
+		t.Errorf("expected ids 0..2 in order after close, got %v", got)
+	}
+}
+
+// TestObserveChanConcurrentRestore verifies a restore racing ObserveChan
+// always closes the channel of the observer it removes.
+func TestObserveChanConcurrentRestore(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	empty := c.Snapshot()
+	for i := 0; i < 50; i++ {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.RestoreSnapshot(empty)
+		}()
+		events, obs := c.ObserveChan(1)
+		wg.Wait()
+		c.RestoreSnapshot(empty)
+
+		if obs.IsActive() {
+			t.Fatal("expected restore to close the observer")
+		}
+		select {
+		case _, ok := <-events:
+			if ok {
+				t.Fatal("expected no events on the closed channel")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected removed observer's channel to be closed")
+		}
+	}
+}
+
+func TestObserveChanOverflow(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.observe.chan.overflow", "Test observe chan overflow signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	events, obs := c.ObserveChan(2)
+	defer obs.Close()
+
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig)
+	}
+
+	if len(events) != 2 {
+		t.Errorf("expected full buffer of 2, got %d", len(events))
+	}
+	if dropped := c.Stats().DropCounts[DropReasonOverflow]; dropped != 3 {
+		t.Errorf("expected 3 overflow drops, got %d", dropped)
+	}
+}
+
+func TestHookCtx(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hook.ctx", "Test hook ctx signal")
+	var calls int
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := c.HookCtx(ctx, sig, func(_ context.Context, _ *Event) { calls++ })
+
+	c.Emit(context.Background(), sig)
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for listener.Active() {
+		if time.Now().After(deadline) {
+			t.Fatal("expected listener closed after context cancel")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Emit(context.Background(), sig)
+	if calls != 1 {
+		t.Errorf("expected 1 call before cancel, got %d", calls)
+	}
+}
+
+func TestHookCtxManualClose(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hook.ctx.manual", "Test hook ctx manual close signal")
+	listener := c.HookCtx(context.Background(), sig, func(_ context.Context, _ *Event) {})
+
+	listener.Close()
+	listener.Close()
+	if listener.Active() {
+		t.Error("expected listener inactive after Close")
+	}
+	select {
+	case <-listener.done:
+	default:
+		t.Error("expected Close to release the context goroutine")
+	}
+}
+
+func TestListenerCount(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.listener.count", "Test listener count signal")
+	if c.HasListeners(sig) || c.ListenerCount(sig) != 0 {
+		t.Fatal("expected no listeners before hook")
+	}
+
+	first := c.Hook(sig, func(_ context.Context, _ *Event) {})
+	second := c.Hook(sig, func(_ context.Context, _ *Event) {})
+	if !c.HasListeners(sig) || c.ListenerCount(sig) != 2 {
+		t.Errorf("expected 2 listeners after hook, got %d", c.ListenerCount(sig))
+	}
+
+	first.Close()
+	second.Close()
+	if c.HasListeners(sig) || c.ListenerCount(sig) != 0 {
+		t.Errorf("expected no listeners after close, got %d", c.ListenerCount(sig))
+	}
+}
+
+func TestListenerCountObservers(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	hooked := NewSignal("test.listener.count.hooked", "Test listener count hooked signal")
+	unseen := NewSignal("test.listener.count.unseen", "Test listener count unseen signal")
+	other := NewSignal("test.listener.count.other", "Test listener count other signal")
+	c.Hook(hooked, func(_ context.Context, _ *Event) {})
+
+	all := c.Observe(func(_ context.Context, _ *Event) {})
+	c.Observe(func(_ context.Context, _ *Event) {}, unseen)
+
+	if got := c.ListenerCount(hooked); got != 2 {
+		t.Errorf("expected hook plus all-signals observer, got %d", got)
+	}
+	if got := c.ListenerCount(unseen); got != 2 {
+		t.Errorf("expected both observers for a signal not yet seen, got %d", got)
+	}
+	if got := c.ListenerCount(other); got != 1 {
+		t.Errorf("expected only the all-signals observer, got %d", got)
+	}
+
+	all.Close()
+	if c.HasListeners(other) {
+		t.Error("expected no listeners after the observer closed")
+	}
+}
+
+func TestListenerSetCallback(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.listener.set.callback", "Test listener set callback signal")
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var mu sync.Mutex
+	var got []string
+	listener := c.Hook(sig, func(_ context.Context, _ *Event) {
+		mu.Lock()
+		got = append(got, "old")
+		first := len(got) == 1
+		mu.Unlock()
+		if first {
+			close(started)
+			<-release
+		}
+	})
+
+	c.Emit(context.Background(), sig)
+	<-started
+	c.Emit(context.Background(), sig) // queued while the old callback runs
+
+	listener.SetCallback(func(_ context.Context, _ *Event) {
+		mu.Lock()
+		got = append(got, "new")
+		mu.Unlock()
+	})
+	listener.SetCallback(nil)
+	close(release)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 2 || got[0] != "old" || got[1] != "new" {
+		t.Errorf("expected in-progress delivery to finish with the old callback and queued event to use the new one, got %v", got)
+	}
+}
+
+func TestListenerSetCallbackConcurrent(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.listener.set.callback.concurrent", "Test listener set callback concurrent signal")
+	var calls atomic.Int64
+	listener := c.Hook(sig, func(_ context.Context, _ *Event) { calls.Add(1) })
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			c.Emit(context.Background(), sig)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			listener.SetCallback(func(_ context.Context, _ *Event) { calls.Add(1) })
+		}
+	}()
+	wg.Wait()
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	if got := calls.Load(); got != 100 {
+		t.Errorf("expected every event delivered exactly once, got %d", got)
+	}
+}
+
+func TestHookNamed(t *testing.T) {
+	var slow []string
+	c := New(
+		WithSyncMode(),
+		WithDeadLetter(10),
+		WithSlowListenerThreshold(-1, func(_ Signal, name string, _ time.Duration) {
+			slow = append(slow, name)
+		}),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.listener.named", "Test named listener signal")
+	audit := c.HookNamed(sig, "audit", func(_ context.Context, _ *Event) {})
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	c.HookNamed(sig, "billing", func(_ context.Context, _ *Event) { panic("boom") })
+
+	if audit.Name() != "audit" {
+		t.Errorf("expected name audit, got %q", audit.Name())
+	}
+
+	stats := c.Stats()
+	names := stats.ListenerNames[sig]
+	if len(names) != 2 || names[0] != "audit" || names[1] != "billing" {
+		t.Errorf("expected named listeners only, got %v", names)
+	}
+
+	c.Emit(context.Background(), sig)
+
+	dead := c.DeadLetters()
+	if len(dead) != 1 || dead[0].Listener != "billing" {
+		t.Errorf("expected panic dead-lettered with listener name, got %+v", dead)
+	}
+	if len(slow) != 2 || slow[0] != "audit" {
+		t.Errorf("expected slow-listener callback to report names, got %v", slow)
+	}
+
+	audit.Close()
+	if names := c.Stats().ListenerNames[sig]; len(names) != 1 || names[0] != "billing" {
+		t.Errorf("expected closed listener's name removed, got %v", names)
+	}
+}
@@ -0,0 +1,50 @@
+package capitan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// emitChainKey is the context key holding the chain of signals whose
+// listeners led to the current emit.
+type emitChainKey struct{}
+
+// EmitLoopError describes an emit dropped by WithMaxEmitDepth.
+// It matches ErrEmitLoop with errors.Is.
+type EmitLoopError struct {
+	// Chain lists the signals from the outermost emit to the dropped one.
+	Chain    []Signal
+	MaxDepth int
+}
+
+// Error implements error.
+func (e *EmitLoopError) Error() string {
+	names := make([]string, len(e.Chain))
+	for i, sig := range e.Chain {
+		names[i] = sig.Name()
+	}
+	return fmt.Sprintf("%s: depth %d exceeds %d: %s",
+		ErrEmitLoop, len(e.Chain), e.MaxDepth, strings.Join(names, " -> "))
+}
+
+// Unwrap returns ErrEmitLoop.
+func (e *EmitLoopError) Unwrap() error {
+	return ErrEmitLoop
+}
+
+// trackDepth appends signal to the emit chain carried by ctx, failing with an
+// *EmitLoopError if the chain would exceed the configured maximum depth.
+// Listeners receive the event context, so nested emits see the extended chain
+// in both sync and async modes.
+func (c *Capitan) trackDepth(ctx context.Context, signal Signal) (context.Context, error) {
+	parent, _ := ctx.Value(emitChainKey{}).([]Signal) // absent chain means depth 0
+	chain := make([]Signal, len(parent)+1)
+	copy(chain, parent)
+	chain[len(parent)] = signal
+
+	if len(chain) > c.maxEmitDepth {
+		return ctx, &EmitLoopError{Chain: chain, MaxDepth: c.maxEmitDepth}
+	}
+	return context.WithValue(ctx, emitChainKey{}, chain), nil
+}
@@ -0,0 +1,116 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestMaxEmitDepthSelfLoop(t *testing.T) {
+	for _, mode := range []struct {
+		name string
+		opts []Option
+	}{
+		{"sync", []Option{WithSyncMode()}},
+		{"async", nil},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var errs []error
+			var drops []DropReason
+			opts := append(mode.opts,
+				WithMaxEmitDepth(3),
+				WithErrorHandler(func(_ Signal, err error) {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}),
+				WithDropHandler(func(_ Signal, reason DropReason) {
+					mu.Lock()
+					drops = append(drops, reason)
+					mu.Unlock()
+				}),
+			)
+			c := New(opts...)
+			defer c.Shutdown()
+
+			sig := NewSignal("test.loop.self", "Test self loop signal")
+			calls := 0
+			c.Hook(sig, func(ctx context.Context, _ *Event) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				c.Emit(ctx, sig)
+			})
+
+			c.Emit(context.Background(), sig)
+			if err := c.Flush(context.Background()); err != nil {
+				t.Fatalf("flush failed: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if calls != 3 {
+				t.Errorf("expected loop cut after 3 deliveries, got %d", calls)
+			}
+			if len(drops) != 1 || drops[0] != DropReasonLoop {
+				t.Errorf("expected one loop drop, got %v", drops)
+			}
+			var loopErr *EmitLoopError
+			if len(errs) != 1 || !errors.As(errs[0], &loopErr) || !errors.Is(errs[0], ErrEmitLoop) {
+				t.Fatalf("expected EmitLoopError, got %v", errs)
+			}
+			if len(loopErr.Chain) != 4 || loopErr.MaxDepth != 3 {
+				t.Errorf("expected chain of 4 with max 3, got %+v", loopErr)
+			}
+		})
+	}
+}
+
+func TestMaxEmitDepthCycle(t *testing.T) {
+	var loopErr *EmitLoopError
+	c := New(WithSyncMode(), WithMaxEmitDepth(4), WithErrorHandler(func(_ Signal, err error) {
+		errors.As(err, &loopErr)
+	}))
+	defer c.Shutdown()
+
+	a := NewSignal("test.loop.a", "Test cycle signal A")
+	b := NewSignal("test.loop.b", "Test cycle signal B")
+	c.Hook(a, func(ctx context.Context, _ *Event) { c.Emit(ctx, b) })
+	c.Hook(b, func(ctx context.Context, _ *Event) { c.Emit(ctx, a) })
+
+	c.Emit(context.Background(), a)
+
+	if loopErr == nil {
+		t.Fatal("expected loop to be reported")
+	}
+	want := []Signal{a, b, a, b, a}
+	if len(loopErr.Chain) != len(want) {
+		t.Fatalf("expected chain %v, got %v", want, loopErr.Chain)
+	}
+	for i := range want {
+		if loopErr.Chain[i] != want[i] {
+			t.Errorf("chain[%d]: expected %s, got %s", i, want[i].Name(), loopErr.Chain[i].Name())
+		}
+	}
+	if got := loopErr.Error(); got != "capitan: emit loop detected: depth 5 exceeds 4: test.loop.a -> test.loop.b -> test.loop.a -> test.loop.b -> test.loop.a" {
+		t.Errorf("unexpected message: %s", got)
+	}
+}
+
+func TestMaxEmitDepthSiblingsUnaffected(t *testing.T) {
+	c := New(WithSyncMode(), WithMaxEmitDepth(1))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.loop.siblings", "Test sibling emits signal")
+	calls := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { calls++ })
+
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	if calls != 5 {
+		t.Errorf("expected independent top-level emits to be unaffected, got %d", calls)
+	}
+}
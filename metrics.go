@@ -0,0 +1,235 @@
+package capitan
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsCollector receives instrumentation callbacks from a Capitan instance.
+// Implementations must be safe for concurrent use and should return quickly,
+// as they are invoked on the emit path and inside workers.
+type MetricsCollector interface {
+	// EventEmitted is called for every accepted emission.
+	EventEmitted(signal Signal, severity Severity)
+
+	// EventProcessed is called after all listeners have run for an event.
+	EventProcessed(signal Signal, elapsed time.Duration)
+
+	// EventDropped is called when an event is discarded before reaching its listeners.
+	EventDropped(signal Signal, reason DropReason)
+
+	// ListenerPanicked is called when a listener panics.
+	ListenerPanicked(signal Signal)
+}
+
+// InMemoryMetrics is a MetricsCollector that keeps counters in memory.
+// Every Capitan instance uses one internally to back Stats.
+type InMemoryMetrics struct {
+	mu         sync.Mutex
+	emitted    map[Signal]uint64
+	severities map[Severity]uint64
+	processed  map[Signal]uint64
+	succeeded  map[Signal]uint64
+	dropped    map[DropReason]uint64
+	panics     map[Signal]uint64
+
+	// signalSeverities is only tracked when detailed is set.
+	detailed         bool
+	signalSeverities map[Signal]map[Severity]uint64
+}
+
+// NewInMemoryMetrics creates an empty InMemoryMetrics.
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{
+		emitted:          make(map[Signal]uint64),
+		severities:       make(map[Severity]uint64),
+		processed:        make(map[Signal]uint64),
+		succeeded:        make(map[Signal]uint64),
+		dropped:          make(map[DropReason]uint64),
+		panics:           make(map[Signal]uint64),
+		signalSeverities: make(map[Signal]map[Severity]uint64),
+	}
+}
+
+// TrackSignalSeverities enables per-signal severity counts, reported by
+// SignalSeverities. Off by default because of the nested map cost.
+func (m *InMemoryMetrics) TrackSignalSeverities() {
+	m.mu.Lock()
+	m.detailed = true
+	m.mu.Unlock()
+}
+
+// EventEmitted increments the emit counts for the signal and severity.
+func (m *InMemoryMetrics) EventEmitted(signal Signal, severity Severity) {
+	m.countEmitted(signal, severity)
+}
+
+// countEmitted increments the emit counts and returns the signal's new total.
+func (m *InMemoryMetrics) countEmitted(signal Signal, severity Severity) uint64 {
+	m.mu.Lock()
+	m.emitted[signal]++
+	total := m.emitted[signal]
+	m.severities[severity]++
+	if m.detailed {
+		counts, ok := m.signalSeverities[signal]
+		if !ok {
+			counts = make(map[Severity]uint64)
+			m.signalSeverities[signal] = counts
+		}
+		counts[severity]++
+	}
+	m.mu.Unlock()
+	return total
+}
+
+// EventProcessed increments the processed count for the signal.
+func (m *InMemoryMetrics) EventProcessed(signal Signal, _ time.Duration) {
+	m.mu.Lock()
+	m.processed[signal]++
+	m.mu.Unlock()
+}
+
+// eventSucceeded increments the count of events every listener handled
+// without an error or panic. Reported by the owning instance, not through
+// MetricsCollector.
+func (m *InMemoryMetrics) eventSucceeded(signal Signal) {
+	m.mu.Lock()
+	m.succeeded[signal]++
+	m.mu.Unlock()
+}
+
+// EventDropped increments the drop count for the reason.
+func (m *InMemoryMetrics) EventDropped(_ Signal, reason DropReason) {
+	m.mu.Lock()
+	m.dropped[reason]++
+	m.mu.Unlock()
+}
+
+// ListenerPanicked increments the panic count for the signal.
+func (m *InMemoryMetrics) ListenerPanicked(signal Signal) {
+	m.mu.Lock()
+	m.panics[signal]++
+	m.mu.Unlock()
+}
+
+// Emitted returns a copy of the emit counts per signal.
+func (m *InMemoryMetrics) Emitted() map[Signal]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.emitted)
+}
+
+// Severities returns a copy of the emit counts per severity.
+func (m *InMemoryMetrics) Severities() map[Severity]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.severities)
+}
+
+// SignalSeverities returns a copy of the emit counts per signal and severity.
+// Empty unless TrackSignalSeverities was called.
+func (m *InMemoryMetrics) SignalSeverities() map[Signal]map[Severity]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[Signal]map[Severity]uint64, len(m.signalSeverities))
+	for signal, counts := range m.signalSeverities {
+		result[signal] = copyCounts(counts)
+	}
+	return result
+}
+
+// Processed returns a copy of the processed counts per signal.
+func (m *InMemoryMetrics) Processed() map[Signal]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.processed)
+}
+
+// Succeeded returns a copy of the per-signal counts of events every listener
+// handled without an error or panic. Only counted by the instance that owns
+// the metrics.
+func (m *InMemoryMetrics) Succeeded() map[Signal]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.succeeded)
+}
+
+// Dropped returns a copy of the drop counts per reason.
+func (m *InMemoryMetrics) Dropped() map[DropReason]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.dropped)
+}
+
+// Panics returns a copy of the listener panic counts per signal.
+func (m *InMemoryMetrics) Panics() map[Signal]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.panics)
+}
+
+// releaseSignals discards the per-signal counters, freeing their memory.
+func (m *InMemoryMetrics) releaseSignals() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emitted = make(map[Signal]uint64)
+	m.processed = make(map[Signal]uint64)
+	m.succeeded = make(map[Signal]uint64)
+	m.panics = make(map[Signal]uint64)
+	m.signalSeverities = make(map[Signal]map[Severity]uint64)
+}
+
+// releaseSignal discards one signal's counters.
+func (m *InMemoryMetrics) releaseSignal(signal Signal) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.emitted, signal)
+	delete(m.processed, signal)
+	delete(m.succeeded, signal)
+	delete(m.panics, signal)
+	delete(m.signalSeverities, signal)
+}
+
+// copyCounts returns a defensive copy of a counter map.
+func copyCounts[K comparable](counts map[K]uint64) map[K]uint64 {
+	result := make(map[K]uint64, len(counts))
+	for k, v := range counts {
+		result[k] = v
+	}
+	return result
+}
+
+// recordEmitted reports an emission to the internal and configured collectors.
+func (c *Capitan) recordEmitted(signal Signal, severity Severity) {
+	total := c.metrics.countEmitted(signal, severity)
+	if c.collector != nil {
+		c.collector.EventEmitted(signal, severity)
+	}
+	if c.hasThresholds.Load() {
+		c.checkThresholds(signal, total)
+	}
+}
+
+// recordProcessed reports a processed event to the internal and configured collectors.
+func (c *Capitan) recordProcessed(signal Signal, elapsed time.Duration) {
+	c.metrics.EventProcessed(signal, elapsed)
+	if c.collector != nil {
+		c.collector.EventProcessed(signal, elapsed)
+	}
+}
+
+// recordDropped reports a dropped event to the internal and configured collectors.
+func (c *Capitan) recordDropped(signal Signal, reason DropReason) {
+	c.metrics.EventDropped(signal, reason)
+	if c.collector != nil {
+		c.collector.EventDropped(signal, reason)
+	}
+}
+
+// recordPanic reports a listener panic to the internal and configured collectors.
+func (c *Capitan) recordPanic(signal Signal) {
+	c.metrics.ListenerPanicked(signal)
+	if c.collector != nil {
+		c.collector.ListenerPanicked(signal)
+	}
+}
@@ -0,0 +1,248 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCollector counts MetricsCollector callbacks.
+type fakeCollector struct {
+	mu        sync.Mutex
+	emitted   map[Severity]int
+	processed int
+	dropped   map[DropReason]int
+	panics    int
+}
+
+func newFakeCollector() *fakeCollector {
+	return &fakeCollector{
+		emitted: make(map[Severity]int),
+		dropped: make(map[DropReason]int),
+	}
+}
+
+func (f *fakeCollector) EventEmitted(_ Signal, severity Severity) {
+	f.mu.Lock()
+	f.emitted[severity]++
+	f.mu.Unlock()
+}
+
+func (f *fakeCollector) EventProcessed(_ Signal, _ time.Duration) {
+	f.mu.Lock()
+	f.processed++
+	f.mu.Unlock()
+}
+
+func (f *fakeCollector) EventDropped(_ Signal, reason DropReason) {
+	f.mu.Lock()
+	f.dropped[reason]++
+	f.mu.Unlock()
+}
+
+func (f *fakeCollector) ListenerPanicked(_ Signal) {
+	f.mu.Lock()
+	f.panics++
+	f.mu.Unlock()
+}
+
+// TestMetricsCollectorCallCounts verifies each call site reports to the collector.
+func TestMetricsCollectorCallCounts(t *testing.T) {
+	mc := newFakeCollector()
+	c := New(WithSyncMode(), WithMetricsCollector(mc))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.metrics", "Test metrics signal")
+	boom := NewSignal("test.metrics.panic", "Test metrics panic signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	c.Hook(boom, func(_ context.Context, _ *Event) { panic("boom") })
+
+	c.Info(context.Background(), sig)
+	c.Warn(context.Background(), sig)
+	c.Emit(context.Background(), boom)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Emit(ctx, sig)
+
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if mc.emitted[SeverityInfo] != 3 || mc.emitted[SeverityWarn] != 1 {
+		t.Errorf("unexpected emitted counts: %v", mc.emitted)
+	}
+	if mc.processed != 3 {
+		t.Errorf("expected 3 processed, got %d", mc.processed)
+	}
+	if mc.dropped[DropReasonCanceled] != 1 {
+		t.Errorf("expected 1 canceled drop, got %v", mc.dropped)
+	}
+	if mc.panics != 1 {
+		t.Errorf("expected 1 panic, got %d", mc.panics)
+	}
+}
+
+// TestMetricsCollectorMatchesStats verifies the built-in counters backing
+// Stats agree with a configured collector.
+func TestMetricsCollectorMatchesStats(t *testing.T) {
+	mc := NewInMemoryMetrics()
+	c := New(WithMetricsCollector(mc))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.metrics.stats", "Test metrics stats signal")
+	var wg sync.WaitGroup
+	wg.Add(5)
+	c.Hook(sig, func(_ context.Context, _ *Event) { wg.Done() })
+
+	for i := 0; i < 3; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, nil})
+	wg.Wait()
+
+	stats := c.Stats()
+	if stats.EmitCounts[sig] != 5 {
+		t.Errorf("expected 5 emits in stats, got %d", stats.EmitCounts[sig])
+	}
+	if got := mc.Emitted()[sig]; got != stats.EmitCounts[sig] {
+		t.Errorf("collector emitted %d, stats %d", got, stats.EmitCounts[sig])
+	}
+	if len(mc.Dropped()) != len(stats.DropCounts) {
+		t.Errorf("collector drops %v, stats %v", mc.Dropped(), stats.DropCounts)
+	}
+}
+
+// TestInMemoryMetricsCopies verifies accessors return defensive copies.
+func TestInMemoryMetricsCopies(t *testing.T) {
+	sig := NewSignal("test.metrics.copies", "Test metrics copies signal")
+	m := NewInMemoryMetrics()
+	m.EventEmitted(sig, SeverityInfo)
+	m.EventProcessed(sig, time.Millisecond)
+	m.ListenerPanicked(sig)
+
+	emitted := m.Emitted()
+	emitted[sig] = 100
+
+	if m.Emitted()[sig] != 1 {
+		t.Error("mutating the returned map affected the collector")
+	}
+	if m.Processed()[sig] != 1 || m.Panics()[sig] != 1 {
+		t.Errorf("unexpected counts: processed=%v panics=%v", m.Processed(), m.Panics())
+	}
+}
+
+// TestStatsSeverityCounts verifies emits are broken down by severity.
+func TestStatsSeverityCounts(t *testing.T) {
+	c := New(WithSyncMode(), WithDetailedStats())
+	defer c.Shutdown()
+
+	sig1 := NewSignal("test.metrics.severity.1", "Test severity counts signal 1")
+	sig2 := NewSignal("test.metrics.severity.2", "Test severity counts signal 2")
+	c.Hook(sig1, func(_ context.Context, _ *Event) {})
+
+	ctx := context.Background()
+	c.Debug(ctx, sig1)
+	c.Info(ctx, sig1)
+	c.Emit(ctx, sig1)
+	c.Warn(ctx, sig2)
+	c.Error(ctx, sig1)
+	c.Error(ctx, sig2)
+	c.EmitSeverity(ctx, sig2, "AUDIT")
+
+	stats := c.Stats()
+	want := map[Severity]uint64{SeverityDebug: 1, SeverityInfo: 2, SeverityWarn: 1, SeverityError: 2, "AUDIT": 1}
+	for sev, n := range want {
+		if stats.SeverityCounts[sev] != n {
+			t.Errorf("%s: expected %d, got %d", sev, n, stats.SeverityCounts[sev])
+		}
+	}
+	if stats.SignalSeverityCounts[sig1][SeverityError] != 1 || stats.SignalSeverityCounts[sig2][SeverityError] != 1 {
+		t.Errorf("unexpected per-signal Error counts: %v", stats.SignalSeverityCounts)
+	}
+	if stats.SignalSeverityCounts[sig1][SeverityInfo] != 2 || stats.SignalSeverityCounts[sig2][SeverityInfo] != 0 {
+		t.Errorf("unexpected per-signal Info counts: %v", stats.SignalSeverityCounts)
+	}
+}
+
+// TestStatsProcessedCounts verifies only events every listener handled are counted.
+func TestStatsProcessedCounts(t *testing.T) {
+	c := New(WithSyncMode(), WithErrorPolicy(ErrorPolicyIgnore))
+	defer c.Shutdown()
+
+	ok := NewSignal("test.metrics.processed.ok", "Test processed ok signal")
+	failing := NewSignal("test.metrics.processed.fail", "Test processed failing signal")
+	dropped := NewSignal("test.metrics.processed.dropped", "Test processed dropped signal")
+	fail := NewBoolKey("fail")
+
+	c.Hook(ok, func(_ context.Context, _ *Event) {})
+	c.Hook(ok, func(_ context.Context, _ *Event) {})
+	c.Hook(failing, func(_ context.Context, _ *Event) {})
+	c.HookE(failing, func(_ context.Context, e *Event) error {
+		if v, _ := fail.From(e); v {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	c.Hook(failing, func(_ context.Context, e *Event) {
+		if v, _ := fail.From(e); v {
+			panic("boom")
+		}
+	})
+
+	ctx := context.Background()
+	c.Emit(ctx, ok)
+	c.Emit(ctx, ok)
+	c.Emit(ctx, failing, fail.Field(true))
+	c.Emit(ctx, failing, fail.Field(false))
+	c.Emit(ctx, dropped)
+
+	stats := c.Stats()
+	if stats.ProcessedCounts[ok] != 2 {
+		t.Errorf("expected 2 processed on ok signal, got %d", stats.ProcessedCounts[ok])
+	}
+	if stats.ProcessedCounts[failing] != 1 || stats.EmitCounts[failing] != 2 {
+		t.Errorf("expected 1 of 2 failing-signal events processed, got %d of %d",
+			stats.ProcessedCounts[failing], stats.EmitCounts[failing])
+	}
+	if stats.ProcessedCounts[dropped] != 0 || stats.EmitCounts[dropped] != 1 {
+		t.Errorf("expected emitted-but-dropped event not processed, got %d of %d",
+			stats.ProcessedCounts[dropped], stats.EmitCounts[dropped])
+	}
+}
+
+// TestStatsSignalSeverityCountsRequiresDetailed verifies the nested map is opt-in.
+func TestStatsSignalSeverityCountsRequiresDetailed(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.metrics.severity.plain", "Test plain severity counts signal")
+	c.Error(context.Background(), sig)
+
+	stats := c.Stats()
+	if stats.SeverityCounts[SeverityError] != 1 {
+		t.Errorf("expected 1 Error emit, got %d", stats.SeverityCounts[SeverityError])
+	}
+	if stats.SignalSeverityCounts != nil {
+		t.Errorf("expected no per-signal counts without WithDetailedStats, got %v", stats.SignalSeverityCounts)
+	}
+}
+
+// TestModuleLevelSeverityCounts verifies module-level helpers update the default instance.
+func TestModuleLevelSeverityCounts(t *testing.T) {
+	sig := NewSignal("test.metrics.severity.module", "Test module severity counts signal")
+	before := Default().Stats().SeverityCounts
+
+	ctx := context.Background()
+	Debug(ctx, sig)
+	Info(ctx, sig)
+	Warn(ctx, sig)
+	Error(ctx, sig)
+	Error(ctx, sig)
+
+	after := Default().Stats().SeverityCounts
+	if after[SeverityError]-before[SeverityError] != 2 || after[SeverityDebug]-before[SeverityDebug] != 1 {
+		t.Errorf("unexpected module-level severity deltas: before %v, after %v", before, after)
+	}
+}
@@ -1,6 +1,12 @@
 package capitan
 
-import "sync"
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
 
 // Observer represents a subscription to all signals (dynamic).
 // Call Close() to unregister all listeners.
@@ -9,8 +15,14 @@ type Observer struct {
 	callback  EventCallback
 	capitan   *Capitan
 	active    bool
-	signals   map[Signal]struct{} // nil = all signals, non-nil = whitelist
+	signals   map[Signal]struct{} // nil = all signals, non-nil = whitelist; replaced under the Capitan's write lock
 	mu        sync.Mutex
+
+	// paused suppresses delivery to every listener of the observer.
+	paused atomic.Bool
+
+	// onClose runs once after Close has removed every listener.
+	onClose func()
 }
 
 // Close removes all individual listeners from the registry.
@@ -43,6 +55,131 @@ func (o *Observer) Close() {
 	for _, l := range listeners {
 		l.Close()
 	}
+
+	if o.onClose != nil {
+		o.onClose()
+	}
+}
+
+// Pause stops delivery to the observer without detaching it, so it keeps its
+// place among each signal's listeners. Events processed while paused are
+// skipped, not queued for later. Safe to call at any time.
+func (o *Observer) Pause() {
+	o.paused.Store(true)
+}
+
+// Resume restarts delivery to a paused observer, beginning with the next
+// event processed.
+func (o *Observer) Resume() {
+	o.paused.Store(false)
+}
+
+// Paused reports whether the observer is paused.
+func (o *Observer) Paused() bool {
+	return o.paused.Load()
+}
+
+// SetSignals replaces the observer's whitelist: it detaches from signals no
+// longer included and attaches to included signals the instance already
+// knows, while future signals are matched against the new whitelist. With no
+// signals, the observer observes every signal. Attachments to signals that
+// remain included are kept, along with their place among the listeners.
+// Has no effect on a closed observer.
+func (o *Observer) SetSignals(signals ...Signal) {
+	var whitelist map[Signal]struct{}
+	if len(signals) > 0 {
+		whitelist = make(map[Signal]struct{}, len(signals))
+		for _, sig := range signals {
+			whitelist[sig] = struct{}{}
+		}
+	}
+	covers := func(signal Signal) bool {
+		if whitelist == nil {
+			return true
+		}
+		_, ok := whitelist[signal]
+		return ok
+	}
+
+	c := o.capitan
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.active {
+		return
+	}
+	o.signals = whitelist
+
+	// Serial observers filter on the whitelist at emit time and hold no attachments
+	if !slices.Contains(c.observers, o) {
+		return
+	}
+
+	attached := make(map[Signal]bool, len(o.listeners))
+	kept := o.listeners[:0]
+	for _, l := range o.listeners {
+		if covers(l.signal) {
+			kept = append(kept, l)
+			attached[l.signal] = true
+			continue
+		}
+		c.unregisterLocked(l)
+	}
+	o.listeners = kept
+
+	for signal := range c.registry {
+		if attached[signal] || !covers(signal) {
+			continue
+		}
+		listener := &Listener{
+			signal:   signal,
+			callback: o.callback,
+			capitan:  c,
+			observer: o,
+		}
+		c.registry[signal] = append(c.registry[signal], listener)
+		listener.active.Store(true)
+		o.listeners = append(o.listeners, listener)
+	}
+}
+
+// Signals returns the signals the observer is currently attached to, sorted
+// by name. An observer attaches to a signal once the instance has seen it, so
+// a whitelisted signal that was never hooked or emitted is not listed yet.
+// Serial observers hold no attachments and return nil.
+func (o *Observer) Signals() []Signal {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.listeners) == 0 {
+		return nil
+	}
+	signals := make([]Signal, len(o.listeners))
+	for i, l := range o.listeners {
+		signals[i] = l.signal
+	}
+	slices.SortFunc(signals, func(a, b Signal) int { return strings.Compare(a.name, b.name) })
+	return signals
+}
+
+// IsActive reports whether the observer is still registered: true until it
+// is closed.
+func (o *Observer) IsActive() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.active
+}
+
+// stats reports the observer's kind and attachment count.
+func (o *Observer) stats() ObserverStats {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	kind := ObserverAll
+	if o.signals != nil {
+		kind = ObserverWhitelist
+	}
+	return ObserverStats{Kind: kind, AttachedSignals: len(o.listeners)}
 }
 
 // Observe registers a callback for all signals on the default instance (dynamic).
@@ -60,6 +197,12 @@ func Observe(callback EventCallback, signals ...Signal) *Observer {
 // The observer will receive events from both existing and future signals.
 // Returns an Observer that can be closed to unregister all listeners.
 func (c *Capitan) Observe(callback EventCallback, signals ...Signal) *Observer {
+	return c.observe(callback, nil, signals)
+}
+
+// observe registers an observer whose close hook is set before it is
+// published, so a concurrent Close or Restore never sees it without one.
+func (c *Capitan) observe(callback EventCallback, onClose func(), signals []Signal) *Observer {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -69,6 +212,7 @@ func (c *Capitan) Observe(callback EventCallback, signals ...Signal) *Observer {
 		capitan:   c,
 		active:    true,
 		signals:   nil, // nil = observe all
+		onClose:   onClose,
 	}
 
 	// Build whitelist if signals provided
@@ -92,8 +236,10 @@ func (c *Capitan) Observe(callback EventCallback, signals ...Signal) *Observer {
 			signal:   signal,
 			callback: callback,
 			capitan:  c,
+			observer: o,
 		}
 		c.registry[signal] = append(c.registry[signal], listener)
+		listener.active.Store(true)
 		o.listeners = append(o.listeners, listener)
 	}
 
@@ -103,6 +249,24 @@ func (c *Capitan) Observe(callback EventCallback, signals ...Signal) *Observer {
 	return o
 }
 
+// ObserveSeverity registers an observer on the default instance that only
+// receives events at or above minSev.
+func ObserveSeverity(minSev Severity, callback EventCallback, signals ...Signal) *Observer {
+	return defaultInstance().ObserveSeverity(minSev, callback, signals...)
+}
+
+// ObserveSeverity registers an observer that only receives events whose
+// severity ranks at or above minSev in the instance's severity ordering.
+// Signals act as a whitelist exactly as in Observe. Severities missing from
+// the ordering are always delivered, matching WithMinSeverity.
+func (c *Capitan) ObserveSeverity(minSev Severity, callback EventCallback, signals ...Signal) *Observer {
+	return c.Observe(func(ctx context.Context, e *Event) {
+		if c.severityAtLeast(e.severity, minSev) {
+			callback(ctx, e)
+		}
+	}, signals...)
+}
+
 // attachObservers attaches all active observers to a signal.
 // Must be called while holding c.mu write lock.
 func (c *Capitan) attachObservers(signal Signal) {
@@ -121,10 +285,55 @@ func (c *Capitan) attachObservers(signal Signal) {
 				signal:   signal,
 				callback: obs.callback,
 				capitan:  c,
+				observer: obs,
 			}
 			c.registry[signal] = append(c.registry[signal], obsListener)
+			obsListener.active.Store(true)
 			obs.listeners = append(obs.listeners, obsListener)
 		}
 		obs.mu.Unlock()
 	}
 }
+
+// ObserveChan registers an observer on the default instance that delivers
+// events on a channel.
+func ObserveChan(buffer int, signals ...Signal) (<-chan *Event, *Observer) {
+	return defaultInstance().ObserveChan(buffer, signals...)
+}
+
+// ObserveChan registers an observer that delivers copies of events on a
+// channel instead of calling a callback, for consumers that prefer a select
+// loop. Signals act as a whitelist exactly as in Observe. The copies are
+// never returned to the pool, so receivers may keep them.
+//
+// Sends never block the signal's worker: when the channel's buffer is full,
+// the event is dropped for this observer and counted under
+// DropReasonOverflow. Closing the observer closes the channel once in-flight
+// sends finish; events already buffered can still be received.
+func (c *Capitan) ObserveChan(buffer int, signals ...Signal) (<-chan *Event, *Observer) {
+	ch := make(chan *Event, max(buffer, 0))
+	var mu sync.RWMutex
+	closed := false
+
+	deliver := func(_ context.Context, e *Event) {
+		mu.RLock()
+		defer mu.RUnlock()
+		if closed {
+			return
+		}
+		copied := e.clone(c.pool)
+		select {
+		case ch <- copied:
+		default:
+			c.pool.Put(copied)
+			c.reportDrop(e.signal, DropReasonOverflow)
+		}
+	}
+	onClose := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		close(ch)
+	}
+	return ch, c.observe(deliver, onClose, signals)
+}
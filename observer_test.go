@@ -2,6 +2,7 @@ package capitan
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -374,3 +375,310 @@ func TestObserverContextPropagation(t *testing.T) {
 		t.Errorf("expected %q, got %q", expectedID, received)
 	}
 }
+
+func TestObserverIntrospection(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig1 := NewSignal("test.introspect.1", "Test introspection signal 1")
+	sig2 := NewSignal("test.introspect.2", "Test introspection signal 2")
+	sig3 := NewSignal("test.introspect.3", "Test introspection signal 3")
+
+	noop := func(_ context.Context, _ *Event) {}
+	c.Hook(sig1, noop)
+	c.Hook(sig2, noop)
+	c.Hook(sig3, noop)
+
+	all := c.Observe(noop)
+	c.Observe(noop, sig1, sig2)
+
+	if count := c.ObserverCount(); count != 2 {
+		t.Errorf("expected 2 observers, got %d", count)
+	}
+
+	stats := c.Stats()
+	if len(stats.Observers) != 2 {
+		t.Fatalf("expected 2 observer stats, got %d", len(stats.Observers))
+	}
+
+	kinds := make(map[ObserverKind]int)
+	for _, obs := range stats.Observers {
+		kinds[obs.Kind] = obs.AttachedSignals
+	}
+	if kinds[ObserverAll] != 3 {
+		t.Errorf("expected all-signals observer attached to 3 signals, got %d", kinds[ObserverAll])
+	}
+	if kinds[ObserverWhitelist] != 2 {
+		t.Errorf("expected whitelist observer attached to 2 signals, got %d", kinds[ObserverWhitelist])
+	}
+
+	all.Close()
+	if count := c.ObserverCount(); count != 1 {
+		t.Errorf("expected 1 observer after close, got %d", count)
+	}
+}
+
+// TestObserveSeverity verifies only events at or above the threshold reach
+// the observer, across signals.
+func TestObserveSeverity(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig1 := NewSignal("test.observe.severity.1", "Test observe severity signal 1")
+	sig2 := NewSignal("test.observe.severity.2", "Test observe severity signal 2")
+
+	var got []Severity
+	c.ObserveSeverity(SeverityError, func(_ context.Context, e *Event) {
+		got = append(got, e.Severity())
+	})
+
+	ctx := context.Background()
+	c.Emit(ctx, sig1)
+	c.Debug(ctx, sig1)
+	c.Warn(ctx, sig2)
+	c.Error(ctx, sig1)
+	c.Error(ctx, sig2)
+
+	if len(got) != 2 || got[0] != SeverityError || got[1] != SeverityError {
+		t.Errorf("expected two Error events, got %v", got)
+	}
+}
+
+// TestObserveSeverityWhitelist verifies severity filtering composes with a whitelist.
+func TestObserveSeverityWhitelist(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	watched := NewSignal("test.observe.severity.watched", "Test observe severity watched signal")
+	ignored := NewSignal("test.observe.severity.ignored", "Test observe severity ignored signal")
+
+	var got []Signal
+	c.ObserveSeverity(SeverityWarn, func(_ context.Context, e *Event) {
+		got = append(got, e.Signal())
+	}, watched)
+
+	ctx := context.Background()
+	c.Info(ctx, watched)
+	c.Warn(ctx, watched)
+	c.Error(ctx, ignored)
+
+	if len(got) != 1 || got[0] != watched {
+		t.Errorf("expected one Warn event on the watched signal, got %v", got)
+	}
+}
+
+// TestObserveSerial verifies a serial observer sees events from several
+// signals in emit order, even while one signal's listener is slow.
+func TestObserveSerial(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	slow := NewSignal("test.observe.serial.slow", "Test observe serial slow signal")
+	fast := NewSignal("test.observe.serial.fast", "Test observe serial fast signal")
+	ignored := NewSignal("test.observe.serial.ignored", "Test observe serial ignored signal")
+	seq := NewIntKey("seq")
+
+	c.Hook(slow, func(_ context.Context, _ *Event) { time.Sleep(time.Millisecond) })
+	c.Hook(fast, func(_ context.Context, _ *Event) {})
+	c.Hook(ignored, func(_ context.Context, _ *Event) {})
+
+	var got []int
+	obs := c.ObserveSerial(func(_ context.Context, e *Event) {
+		v, _ := seq.From(e)
+		got = append(got, v) // callbacks never overlap
+	}, slow, fast)
+
+	for i := 0; i < 20; i++ {
+		sig := fast
+		if i%3 == 0 {
+			sig = slow
+		}
+		c.Emit(context.Background(), sig, seq.Field(i))
+		c.Emit(context.Background(), ignored, seq.Field(-1))
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	if len(got) != 20 {
+		t.Fatalf("expected 20 watched events, got %d: %v", len(got), got)
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expected emit order, got %v", got)
+		}
+	}
+
+	obs.Close()
+	c.Emit(context.Background(), fast, seq.Field(99))
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if len(got) != 20 {
+		t.Errorf("expected no events after close, got %v", got[20:])
+	}
+}
+
+// TestObserveSerialSyncMode verifies serial observers are called inline in sync mode.
+func TestObserveSerialSyncMode(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.observe.serial.sync", "Test observe serial sync signal")
+	var calls int
+	c.ObserveSerial(func(_ context.Context, _ *Event) { calls++ })
+
+	c.Emit(context.Background(), sig)
+	if calls != 1 {
+		t.Errorf("expected inline delivery without listeners, got %d calls", calls)
+	}
+}
+
+func TestObserverPauseResume(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.observer.pause", "Test observer pause signal")
+	var hooked, observed int
+	c.Hook(sig, func(_ context.Context, _ *Event) { hooked++ })
+	obs := c.Observe(func(_ context.Context, _ *Event) { observed++ })
+	defer obs.Close()
+
+	c.Emit(context.Background(), sig)
+	obs.Pause()
+	if !obs.Paused() {
+		t.Error("expected observer to report paused")
+	}
+	c.Emit(context.Background(), sig)
+	c.Emit(context.Background(), sig)
+	obs.Resume()
+	c.Emit(context.Background(), sig)
+
+	if observed != 2 {
+		t.Errorf("expected paused events skipped, got %d observed", observed)
+	}
+	if hooked != 4 {
+		t.Errorf("expected other listeners unaffected, got %d", hooked)
+	}
+	if got := c.ListenerCount(sig); got != 2 {
+		t.Errorf("expected paused observer to stay attached, got %d listeners", got)
+	}
+}
+
+func TestObserverSetSignals(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sigA := NewSignal("test.observer.set.a", "Test observer set signal A")
+	sigB := NewSignal("test.observer.set.b", "Test observer set signal B")
+	sigC := NewSignal("test.observer.set.c", "Test observer set signal C")
+	for _, sig := range []Signal{sigA, sigB, sigC} {
+		c.Hook(sig, func(_ context.Context, _ *Event) {})
+	}
+
+	var got []string
+	obs := c.Observe(func(_ context.Context, e *Event) {
+		got = append(got, e.Signal().Name())
+	}, sigA, sigB)
+	defer obs.Close()
+
+	// Narrow to B, then widen to B and a signal not seen yet
+	obs.SetSignals(sigB)
+	if got := c.ListenerCount(sigA); got != 1 {
+		t.Errorf("expected observer detached from A, got %d listeners", got)
+	}
+	sigD := NewSignal("test.observer.set.d", "Test observer set signal D")
+	obs.SetSignals(sigB, sigD)
+
+	ctx := context.Background()
+	c.Emit(ctx, sigA)
+	c.Emit(ctx, sigB)
+	c.Emit(ctx, sigC)
+	c.Emit(ctx, sigD)
+
+	if len(got) != 2 || got[0] != sigB.Name() || got[1] != sigD.Name() {
+		t.Errorf("expected only B and future D observed, got %v", got)
+	}
+
+	// No signals observes everything
+	got = nil
+	obs.SetSignals()
+	c.Emit(ctx, sigA)
+	c.Emit(ctx, sigC)
+	if len(got) != 2 {
+		t.Errorf("expected all signals observed, got %v", got)
+	}
+
+	obs.Close()
+	obs.SetSignals(sigA)
+	if got := c.ListenerCount(sigA); got != 1 {
+		t.Errorf("expected SetSignals on closed observer to do nothing, got %d listeners", got)
+	}
+}
+
+func TestObserverSetSignalsConcurrent(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	obs := c.Observe(func(_ context.Context, _ *Event) {})
+	defer obs.Close()
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 50 {
+				sig := NewSignal(fmt.Sprintf("test.observer.set.race.%d.%d", i, j), "Test observer race signal")
+				c.Emit(context.Background(), sig)
+				obs.SetSignals(sig)
+				obs.Pause()
+				obs.Resume()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestObserverSignals(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sigA := NewSignal("test.observer.signals.a", "Test observer signals A")
+	sigB := NewSignal("test.observer.signals.b", "Test observer signals B")
+	c.Hook(sigB, func(_ context.Context, _ *Event) {})
+
+	all := c.Observe(func(_ context.Context, _ *Event) {})
+	listed := c.Observe(func(_ context.Context, _ *Event) {}, sigA)
+
+	if got := all.Signals(); len(got) != 1 || got[0] != sigB {
+		t.Errorf("expected all-signals observer on B, got %v", got)
+	}
+	if got := listed.Signals(); len(got) != 0 {
+		t.Errorf("expected whitelisted observer unattached until A is seen, got %v", got)
+	}
+
+	// A appears: both observers attach to it
+	c.Emit(context.Background(), sigA)
+	if got := all.Signals(); len(got) != 2 || got[0] != sigA || got[1] != sigB {
+		t.Errorf("expected all-signals observer to grow to A and B, got %v", got)
+	}
+	if got := listed.Signals(); len(got) != 1 || got[0] != sigA {
+		t.Errorf("expected whitelisted observer on A only, got %v", got)
+	}
+	if stats := c.Stats(); stats.ObserverCount != 2 {
+		t.Errorf("expected 2 observers in stats, got %d", stats.ObserverCount)
+	}
+
+	if !listed.IsActive() {
+		t.Error("expected observer active before Close")
+	}
+	listed.Close()
+	if listed.IsActive() || listed.Signals() != nil {
+		t.Errorf("expected closed observer inactive with no signals, got %v", listed.Signals())
+	}
+	if stats := c.Stats(); stats.ObserverCount != 1 {
+		t.Errorf("expected 1 observer after Close, got %d", stats.ObserverCount)
+	}
+	all.Close()
+}
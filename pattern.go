@@ -0,0 +1,46 @@
+package capitan
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+)
+
+// EmitPattern broadcasts an Info-severity event to every matching signal on the default instance.
+func EmitPattern(ctx context.Context, pattern string, fields ...Field) {
+	defaultInstance().EmitPattern(ctx, pattern, fields...)
+}
+
+// EmitPattern emits the fields with Info severity to every signal that has
+// listeners and whose name matches pattern, such as "shutdown.*" to notify
+// all subsystems. Patterns use path.Match syntax, where * does not match "/".
+// Only signals the instance already knows are targeted; no signal is created
+// by a broadcast. Signals are emitted to in name order. A malformed pattern
+// matches no signals.
+func (c *Capitan) EmitPattern(ctx context.Context, pattern string, fields ...Field) {
+	for _, signal := range c.matchSignals(pattern) {
+		c.Emit(ctx, signal, fields...)
+	}
+}
+
+// matchSignals returns the signals with listeners whose names match pattern,
+// ordered by name.
+func (c *Capitan) matchSignals(pattern string) []Signal {
+	c.mu.RLock()
+	var matched []Signal
+	for signal, listeners := range c.registry {
+		if len(listeners) == 0 {
+			continue
+		}
+		if ok, err := path.Match(pattern, signal.name); ok && err == nil {
+			matched = append(matched, signal)
+		}
+	}
+	c.mu.RUnlock()
+
+	slices.SortFunc(matched, func(a, b Signal) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return matched
+}
@@ -0,0 +1,51 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEmitPattern(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	db := NewSignal("shutdown.db", "Test shutdown db signal")
+	cache := NewSignal("shutdown.cache", "Test shutdown cache signal")
+	nested := NewSignal("shutdown/worker", "Test shutdown nested signal")
+	unrelated := NewSignal("orders.created", "Test unrelated signal")
+	reason := NewStringKey("reason")
+
+	var got []string
+	record := func(_ context.Context, e *Event) {
+		r, _ := reason.From(e)
+		got = append(got, e.Signal().Name()+":"+r)
+	}
+	for _, sig := range []Signal{db, cache, nested, unrelated} {
+		c.Hook(sig, record)
+	}
+
+	c.EmitPattern(context.Background(), "shutdown.*", reason.Field("deploy"))
+
+	if len(got) != 2 || got[0] != "shutdown.cache:deploy" || got[1] != "shutdown.db:deploy" {
+		t.Errorf("expected matching signals in name order, got %v", got)
+	}
+}
+
+func TestEmitPatternOnlyExistingSignals(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	var calls int
+	obs := c.Observe(func(_ context.Context, _ *Event) { calls++ })
+	defer obs.Close()
+
+	c.EmitPattern(context.Background(), "*")
+	c.EmitPattern(context.Background(), "[")
+
+	if calls != 0 {
+		t.Errorf("expected no events without known signals, got %d", calls)
+	}
+	if stats := c.Stats(); len(stats.ListenerCounts) != 0 {
+		t.Errorf("expected broadcast not to create signals, got %v", stats.ListenerCounts)
+	}
+}
@@ -0,0 +1,67 @@
+package capitan
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// publishBuffer is the per-signal queue size used by PublishTo.
+const publishBuffer = 256
+
+// Encoder serializes an event for transport outside the process.
+// Encode must not retain the event after returning.
+type Encoder interface {
+	Encode(e *Event) ([]byte, error)
+}
+
+// JSONEncoder encodes events with Event.MarshalJSON, honoring WithRedactedFields.
+type JSONEncoder struct{}
+
+// Encode implements Encoder.
+func (JSONEncoder) Encode(e *Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Publisher sends encoded events to an external broker such as Kafka or NATS.
+// Implementations wrap the broker's client, keeping capitan free of broker
+// dependencies.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+// PublishTo mirrors events on the given signals to pub. Each event is
+// encoded with enc and published to topicFn(signal) with the event's context.
+// Encode and publish errors are reported to the error handler.
+//
+// Publishing runs on a buffered listener per signal (see HookBuffered), so a
+// slow publisher never blocks the signal's worker; when a signal's queue of
+// 256 events is full, further events are dropped for the publisher only and
+// counted under DropReasonOverflow. Call stop to detach; it waits for events
+// already queued for the publisher. Use Flush first to include events still
+// queued on the signals' workers.
+func PublishTo(c *Capitan, pub Publisher, enc Encoder, topicFn func(Signal) string, signals ...Signal) (stop func()) {
+	listeners := make([]*Listener, 0, len(signals))
+	for _, signal := range signals {
+		topic := topicFn(signal)
+		publish := func(ctx context.Context, e *Event) {
+			payload, err := enc.Encode(e)
+			if err == nil {
+				err = pub.Publish(ctx, topic, payload)
+			}
+			if err != nil && c.errorHandler != nil {
+				c.errorHandler(e.Signal(), err)
+			}
+		}
+		listeners = append(listeners, c.hookBuffered(&Listener{
+			signal:   signal,
+			callback: publish,
+			capitan:  c,
+			internal: true,
+		}, publishBuffer))
+	}
+	return func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package capitan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryPublisher records published messages in memory.
+type memoryPublisher struct {
+	mu       sync.Mutex
+	messages map[string][][]byte
+	err      error
+	block    chan struct{}
+}
+
+func (p *memoryPublisher) Publish(_ context.Context, topic string, payload []byte) error {
+	if p.block != nil {
+		<-p.block
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	if p.messages == nil {
+		p.messages = make(map[string][][]byte)
+	}
+	p.messages[topic] = append(p.messages[topic], payload)
+	return nil
+}
+
+func TestPublishTo(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	created := NewSignal("test.publish.created", "Test publish created signal")
+	paid := NewSignal("test.publish.paid", "Test publish paid signal")
+	ignored := NewSignal("test.publish.ignored", "Test publish ignored signal")
+	orderID := NewStringKey("order_id")
+	c.Hook(ignored, func(_ context.Context, _ *Event) {})
+
+	pub := &memoryPublisher{}
+	stop := PublishTo(c, pub, JSONEncoder{}, func(s Signal) string { return "events." + s.Name() }, created, paid)
+
+	ctx := context.Background()
+	c.Emit(ctx, created, orderID.Field("o-1"))
+	c.Emit(ctx, paid, orderID.Field("o-1"))
+	c.Emit(ctx, ignored, orderID.Field("o-1"))
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	stop()
+
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+	if len(pub.messages) != 2 {
+		t.Fatalf("expected two topics, got %v", pub.messages)
+	}
+	msgs := pub.messages["events.test.publish.created"]
+	if len(msgs) != 1 {
+		t.Fatalf("expected one created message, got %d", len(msgs))
+	}
+	var decoded struct {
+		Signal string         `json:"signal"`
+		Fields map[string]any `json:"fields"`
+	}
+	if err := json.Unmarshal(msgs[0], &decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if decoded.Signal != created.Name() || decoded.Fields["order_id"] != "o-1" {
+		t.Errorf("unexpected payload: %s", msgs[0])
+	}
+}
+
+func TestPublishToErrorsAndBackpressure(t *testing.T) {
+	var mu sync.Mutex
+	var errs []error
+	c := New(WithErrorHandler(func(_ Signal, err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.publish.slow", "Test publish slow signal")
+	errDown := errors.New("broker down")
+	pub := &memoryPublisher{err: errDown, block: make(chan struct{})}
+	stop := PublishTo(c, pub, JSONEncoder{}, func(s Signal) string { return s.Name() }, sig)
+
+	// The blocked publisher must not hold up the worker
+	delivered := make(chan struct{}, publishBuffer*2)
+	c.Hook(sig, func(_ context.Context, _ *Event) { delivered <- struct{}{} })
+	for i := 0; i < publishBuffer+10; i++ {
+		c.Emit(context.Background(), sig)
+	}
+	deadline := time.After(2 * time.Second)
+	for i := 0; i < publishBuffer+10; i++ {
+		select {
+		case <-delivered:
+		case <-deadline:
+			t.Fatalf("worker blocked by slow publisher after %d events", i)
+		}
+	}
+
+	close(pub.block)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	stop()
+
+	if c.Stats().DropCounts[DropReasonOverflow] == 0 {
+		t.Error("expected overflow drops while the publisher was blocked")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) == 0 || !errors.Is(errs[0], errDown) {
+		t.Errorf("expected publish errors reported, got %v", errs)
+	}
+}
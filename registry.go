@@ -0,0 +1,182 @@
+package capitan
+
+import "slices"
+
+// RegistrySnapshot records which listeners and observers an instance had
+// registered at a point in time, so the set can be restored later. It holds
+// the listeners themselves, not copies: restoring re-registers the same
+// callbacks.
+type RegistrySnapshot struct {
+	listeners map[Signal][]*Listener // excludes observer attachments
+	observers []*Observer
+}
+
+// Len returns the number of listeners and observers in the snapshot.
+func (s RegistrySnapshot) Len() int {
+	n := len(s.observers)
+	for _, listeners := range s.listeners {
+		n += len(listeners)
+	}
+	return n
+}
+
+// Snapshot records the default instance's registered listeners and observers.
+func Snapshot() RegistrySnapshot {
+	return defaultInstance().Snapshot()
+}
+
+// Snapshot records the instance's registered listeners and observers, for
+// swapping in a new handler set and rolling back with RestoreSnapshot if the
+// swap fails. Serial observers are not included.
+func (c *Capitan) Snapshot() RegistrySnapshot {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s := RegistrySnapshot{
+		listeners: make(map[Signal][]*Listener, len(c.registry)),
+		observers: slices.Clone(c.observers),
+	}
+	for signal, listeners := range c.registry {
+		for _, l := range listeners {
+			if l.observer == nil {
+				s.listeners[signal] = append(s.listeners[signal], l)
+			}
+		}
+	}
+	return s
+}
+
+// RestoreSnapshot restores the default instance's listeners and observers to s.
+func RestoreSnapshot(s RegistrySnapshot) {
+	defaultInstance().RestoreSnapshot(s)
+}
+
+// RestoreSnapshot makes the registered listeners and observers match s in a
+// single step under the registry lock, so an emit sees either the old set or
+// the new one, never a mix. Listeners and observers registered since the
+// snapshot are closed; those in the snapshot that were closed since are
+// registered again, and restored observers re-attach to every signal they
+// cover. As with Close, an event already being delivered may still reach a
+// listener that the restore removes.
+//
+// Some closes can't be undone: a HookBuffered or HookCtx listener whose
+// goroutine has stopped, and an observer that released a resource on close,
+// such as the channel from ObserveChan, stay closed.
+func (c *Capitan) RestoreSnapshot(s RegistrySnapshot) {
+	keep := make(map[*Listener]bool)
+	for _, listeners := range s.listeners {
+		for _, l := range listeners {
+			keep[l] = true
+		}
+	}
+	keepObservers := make(map[*Observer]bool, len(s.observers))
+	for _, o := range s.observers {
+		keepObservers[o] = true
+	}
+
+	c.mu.Lock()
+	// Closed instances have released their registry
+	if c.registry == nil {
+		c.mu.Unlock()
+		return
+	}
+
+	// Deactivate observers registered since the snapshot
+	closing := make(map[*Observer]bool)
+	observers := c.observers[:0:0]
+	for _, o := range c.observers {
+		if keepObservers[o] {
+			observers = append(observers, o)
+			continue
+		}
+		o.mu.Lock()
+		o.active = false
+		o.listeners = nil
+		o.mu.Unlock()
+		closing[o] = true
+	}
+	c.observers = observers
+
+	// Detach listeners hooked since the snapshot, and the closing observers' attachments
+	var removed []*Listener
+	for signal, listeners := range c.registry {
+		if len(listeners) == 0 {
+			continue
+		}
+		remaining := make([]*Listener, 0, len(listeners))
+		for _, l := range listeners {
+			if keep[l] || (l.observer != nil && !closing[l.observer]) {
+				remaining = append(remaining, l)
+				continue
+			}
+			l.active.Store(false)
+			removed = append(removed, l)
+		}
+		if len(remaining) > 0 {
+			c.registry[signal] = remaining
+			continue
+		}
+		delete(c.registry, signal)
+		if worker, exists := c.workers[signal]; exists {
+			close(worker.done)
+			delete(c.workers, signal)
+		}
+	}
+
+	// Re-register listeners closed since the snapshot
+	for _, listeners := range s.listeners {
+		for _, l := range listeners {
+			if l.capitan == c && !l.Active() && !l.released() {
+				c.register(l)
+			}
+		}
+	}
+
+	// Revive observers closed since the snapshot
+	for _, o := range s.observers {
+		if o.capitan == c && !slices.Contains(c.observers, o) {
+			c.reviveObserver(o)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, l := range removed {
+		l.release()
+	}
+	for o := range closing {
+		if o.onClose != nil {
+			o.onClose()
+		}
+	}
+}
+
+// reviveObserver reactivates a closed observer and attaches it to every
+// registered signal it covers. Observers with a close hook stay closed, as
+// the resource it released can't be restored.
+// Must be called while holding c.mu write lock.
+func (c *Capitan) reviveObserver(o *Observer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.active || o.onClose != nil {
+		return
+	}
+
+	o.active = true
+	for signal := range c.registry {
+		if o.signals != nil {
+			if _, ok := o.signals[signal]; !ok {
+				continue
+			}
+		}
+		listener := &Listener{
+			signal:   signal,
+			callback: o.callback,
+			capitan:  c,
+			observer: o,
+		}
+		c.registry[signal] = append(c.registry[signal], listener)
+		listener.active.Store(true)
+		o.listeners = append(o.listeners, listener)
+	}
+	c.observers = append(c.observers, o)
+}
@@ -0,0 +1,92 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRestoreSnapshot(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("registry.orders", "Test registry signal")
+	other := NewSignal("registry.audit", "Test registry other signal")
+
+	var got []string
+	record := func(name string) EventCallback {
+		return func(_ context.Context, e *Event) { got = append(got, name+":"+e.Signal().Name()) }
+	}
+
+	oldHandler := c.Hook(sig, record("old"))
+	oldObserver := c.Observe(record("old-obs"), sig)
+
+	snap := c.Snapshot()
+	if snap.Len() != 2 {
+		t.Fatalf("expected 2 entries in snapshot, got %d", snap.Len())
+	}
+
+	// Swap in a new handler set
+	oldHandler.Close()
+	oldObserver.Close()
+	newHandler := c.Hook(sig, record("new"))
+	newOther := c.Hook(other, record("new"))
+	newObserver := c.Observe(record("new-obs"))
+
+	c.Emit(context.Background(), sig)
+	if len(got) != 2 || got[0] != "new:registry.orders" || got[1] != "new-obs:registry.orders" {
+		t.Fatalf("expected new handler set, got %v", got)
+	}
+
+	// Roll back
+	got = nil
+	c.RestoreSnapshot(snap)
+	c.Emit(context.Background(), sig)
+	c.Emit(context.Background(), other)
+
+	if len(got) != 2 || got[0] != "old:registry.orders" || got[1] != "old-obs:registry.orders" {
+		t.Errorf("expected old handler set restored, got %v", got)
+	}
+	if !oldHandler.Active() || newHandler.Active() || newOther.Active() {
+		t.Errorf("unexpected listener state: old=%v new=%v other=%v",
+			oldHandler.Active(), newHandler.Active(), newOther.Active())
+	}
+	if c.HasListeners(other) {
+		t.Error("expected signal hooked only after the snapshot to be removed")
+	}
+	if n := c.ObserverCount(); n != 1 {
+		t.Errorf("expected only the restored observer, got %d", n)
+	}
+
+	// Closing a restored observer still works
+	oldObserver.Close()
+	newObserver.Close()
+	got = nil
+	c.Emit(context.Background(), sig)
+	if len(got) != 1 || got[0] != "old:registry.orders" {
+		t.Errorf("expected only the restored listener after closing observers, got %v", got)
+	}
+}
+
+func TestRestoreSnapshotReleasedListeners(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("registry.released", "Test registry released signal")
+	buffered := c.HookBuffered(sig, 4, func(context.Context, *Event) {})
+	events, chanObserver := c.ObserveChan(4)
+
+	snap := c.Snapshot()
+	buffered.Close()
+	chanObserver.Close()
+	c.RestoreSnapshot(snap)
+
+	if buffered.Active() {
+		t.Error("expected released buffered listener to stay closed")
+	}
+	if _, ok := <-events; ok {
+		t.Error("expected ObserveChan channel to stay closed")
+	}
+	if c.HasListeners(sig) {
+		t.Error("expected no listeners after restoring released ones")
+	}
+}
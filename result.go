@@ -0,0 +1,166 @@
+package capitan
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// eventResults is the scratch area listeners write to during EmitWithResult.
+type eventResults struct {
+	mu        sync.Mutex
+	values    map[string]any
+	processed bool // set once the event passes the cancellation check
+}
+
+// SetResult records a value in the event's result scratch area, letting a
+// listener report an outcome back to an EmitWithResult caller (for example,
+// a "persist" flag). Later listeners can read it with ResultValue. Safe for
+// concurrent use by listeners running in parallel.
+// Events not emitted with EmitWithResult have no scratch area, and SetResult
+// is a no-op for them.
+func (e *Event) SetResult(key string, v any) {
+	if e.results == nil {
+		return
+	}
+	e.results.mu.Lock()
+	defer e.results.mu.Unlock()
+	if e.results.values == nil {
+		e.results.values = make(map[string]any)
+	}
+	e.results.values[key] = v
+}
+
+// ResultValue returns a value recorded with SetResult during processing.
+func (e *Event) ResultValue(key string) (any, bool) {
+	if e.results == nil {
+		return nil, false
+	}
+	e.results.mu.Lock()
+	defer e.results.mu.Unlock()
+	v, ok := e.results.values[key]
+	return v, ok
+}
+
+// EmitWithResult dispatches an Info-severity event on the default instance
+// and returns the results its listeners recorded.
+func EmitWithResult(ctx context.Context, signal Signal, fields ...Field) (map[string]any, error) {
+	return defaultInstance().EmitWithResult(ctx, signal, fields...)
+}
+
+// EmitWithResult dispatches an Info-severity event and processes it on the
+// calling goroutine, bypassing the signal's queue, then returns the values
+// listeners recorded with Event.SetResult. The map is empty if no listener
+// recorded anything, and nil if the event wasn't processed; the error is set
+// when it was rejected, as with EmitChecked.
+//
+// Only listeners that run before EmitWithResult returns contribute: results
+// set later by HookBuffered listeners or delayed retries are not collected.
+// The event isn't ordered relative to events queued on the signal's worker,
+// and forwards set up with Forward don't apply.
+func (c *Capitan) EmitWithResult(ctx context.Context, signal Signal, fields ...Field) (map[string]any, error) {
+	ctx, fields, ok, err := c.admit(ctx, signal, SeverityInfo, fields)
+	if !ok {
+		return nil, err
+	}
+
+	timestamp := c.clock()
+	var callerFile string
+	var callerLine int
+	if c.callerInfo {
+		callerFile, callerLine = callerFrame()
+	}
+
+	c.trackEmit(signal, SeverityInfo, 1, fields)
+	if !c.ensureRegistered(signal) {
+		return nil, nil
+	}
+
+	results := &eventResults{}
+	event := c.newEvent(c.eventContext(ctx), signal, SeverityInfo, timestamp, fields...)
+	event.callerFile, event.callerLine = callerFile, callerLine
+	event.results = results
+	c.processEvent(signal, event)
+
+	results.mu.Lock()
+	defer results.mu.Unlock()
+	if !results.processed {
+		// Dropped because its context was canceled
+		return nil, nil
+	}
+	collected := make(map[string]any, len(results.values))
+	for k, v := range results.values {
+		collected[k] = v
+	}
+	return collected, nil
+}
+
+// EmitCollect dispatches an Info-severity event on the default instance and
+// returns its listeners' errors.
+func EmitCollect(ctx context.Context, signal Signal, fields ...Field) []error {
+	return defaultInstance().EmitCollect(ctx, signal, fields...)
+}
+
+// EmitCollect dispatches an Info-severity event, runs every listener on the
+// calling goroutine one after another, and returns the non-nil errors they
+// returned, in listener order. Use it for validation pipelines where each
+// HookE listener checks one rule and every failure matters, not just the first.
+//
+// Collected errors bypass the ErrorPolicy: they are not retried or passed to
+// the error handler. A panicking listener is recovered and reported as usual,
+// and its panic is collected as an error. If the event is rejected before
+// delivery, the rejection is the only error returned. Like EmitWithResult,
+// the event bypasses the signal's queue, and forwards don't apply.
+func (c *Capitan) EmitCollect(ctx context.Context, signal Signal, fields ...Field) []error {
+	ctx, fields, ok, err := c.admit(ctx, signal, SeverityInfo, fields)
+	if !ok {
+		if err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+
+	timestamp := c.clock()
+	var callerFile string
+	var callerLine int
+	if c.callerInfo {
+		callerFile, callerLine = callerFrame()
+	}
+
+	c.trackEmit(signal, SeverityInfo, 1, fields)
+	if !c.ensureRegistered(signal) {
+		return nil
+	}
+
+	event := c.newEvent(c.eventContext(ctx), signal, SeverityInfo, timestamp, fields...)
+	event.callerFile, event.callerLine = callerFile, callerLine
+	defer c.pool.Put(event)
+
+	c.mu.RLock()
+	listeners := slices.Clone(c.registry[signal])
+	c.mu.RUnlock()
+
+	var errs []error
+	start := c.clock()
+	for _, listener := range listeners {
+		if err := c.collectListener(signal, listener, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.recordProcessed(signal, c.clock().Sub(start))
+	if len(errs) == 0 {
+		c.metrics.eventSucceeded(signal)
+	}
+	return errs
+}
+
+// collectListener runs a single listener with panic recovery, returning its
+// error or recovered panic instead of applying the error policy.
+func (c *Capitan) collectListener(signal Signal, listener *Listener, event *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = c.reportPanic(signal, listener, event, r)
+		}
+	}()
+	return listener.invoke(event.ctx, event)
+}
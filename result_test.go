@@ -0,0 +1,167 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestEmitWithResult verifies listeners' results are collected after processing.
+func TestEmitWithResult(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.result", "Test result signal")
+	amount := NewIntKey("amount")
+
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		if v, _ := amount.From(e); v > 100 {
+			e.SetResult("persist", true)
+		}
+	})
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		e.SetResult("checked", true)
+	})
+
+	results, err := c.EmitWithResult(context.Background(), sig, amount.Field(500))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if results["persist"] != true || results["checked"] != true {
+		t.Errorf("expected both results, got %v", results)
+	}
+
+	results, err = c.EmitWithResult(context.Background(), sig, amount.Field(5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := results["persist"]; ok || len(results) != 1 {
+		t.Errorf("expected only the checked result, got %v", results)
+	}
+}
+
+// TestEmitWithResultReadBetweenListeners verifies later listeners see earlier results.
+func TestEmitWithResultReadBetweenListeners(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.result.read", "Test result read signal")
+	var seen any
+	c.Hook(sig, func(_ context.Context, e *Event) { e.SetResult("step", 1) })
+	c.Hook(sig, func(_ context.Context, e *Event) { seen, _ = e.ResultValue("step") })
+
+	if _, err := c.EmitWithResult(context.Background(), sig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if seen != 1 {
+		t.Errorf("expected second listener to read the first's result, got %v", seen)
+	}
+
+	// Ordinary emits have no scratch area
+	var ok bool
+	c.Hook(sig, func(_ context.Context, e *Event) { _, ok = e.ResultValue("step") })
+	c.Emit(context.Background(), sig)
+	if ok {
+		t.Error("expected no results outside EmitWithResult")
+	}
+}
+
+// TestEmitWithResultRejected verifies rejections are returned and no listener runs.
+func TestEmitWithResultRejected(t *testing.T) {
+	sig := NewSignal("test.result.rejected", "Test result rejected signal")
+	errInvalid := errors.New("invalid")
+	c := New(WithPreEmit(sig, func(_ context.Context, _ []Field) ([]Field, error) {
+		return nil, errInvalid
+	}))
+	defer c.Shutdown()
+
+	called := false
+	c.Hook(sig, func(_ context.Context, _ *Event) { called = true })
+
+	results, err := c.EmitWithResult(context.Background(), sig)
+	if !errors.Is(err, errInvalid) || results != nil || called {
+		t.Errorf("expected rejection without processing, got %v %v %v", results, err, called)
+	}
+}
+
+func TestEmitWithResultCanceled(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.result.canceled", "Test result canceled signal")
+	called := false
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		called = true
+		e.SetResult("persist", true)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := c.EmitWithResult(ctx, sig)
+	if err != nil || results != nil || called {
+		t.Errorf("expected nil results for a canceled event, got %v %v %v", results, err, called)
+	}
+}
+
+func TestEmitCollect(t *testing.T) {
+	var handled int
+	c := New(WithErrorHandler(func(Signal, error) { handled++ }))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.emit.collect", "Test emit collect signal")
+	amount := NewIntKey("amount")
+	errNegative := errors.New("amount is negative")
+	errTooLarge := errors.New("amount is too large")
+
+	c.HookE(sig, func(_ context.Context, e *Event) error {
+		if v, _ := amount.From(e); v < 0 {
+			return errNegative
+		}
+		return nil
+	})
+	c.HookE(sig, func(_ context.Context, _ *Event) error { return nil })
+	c.Hook(sig, func(_ context.Context, _ *Event) { panic("rule crashed") })
+	c.HookE(sig, func(_ context.Context, e *Event) error {
+		if v, _ := amount.From(e); v < 0 || v > 100 {
+			return errTooLarge
+		}
+		return nil
+	})
+
+	errs := c.EmitCollect(context.Background(), sig, amount.Field(-5))
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	if errs[0] != errNegative || errs[2] != errTooLarge {
+		t.Errorf("expected errors in listener order, got %v", errs)
+	}
+	if !strings.Contains(errs[1].Error(), "rule crashed") {
+		t.Errorf("expected panic collected as an error, got %v", errs[1])
+	}
+	if handled != 0 {
+		t.Errorf("expected collected errors to bypass the error handler, got %d", handled)
+	}
+	if stats := c.Stats(); stats.ActiveWorkers != 0 {
+		t.Errorf("expected no worker for a collected emit, got %d", stats.ActiveWorkers)
+	}
+}
+
+func TestEmitCollectRejected(t *testing.T) {
+	c := New(WithMaxFields(1))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.emit.collect.rejected", "Test emit collect rejected signal")
+	c.HookE(sig, func(_ context.Context, _ *Event) error { return errors.New("unreached") })
+	key := NewIntKey("n")
+
+	errs := c.EmitCollect(context.Background(), sig, key.Field(1), NewIntKey("m").Field(2))
+	if len(errs) != 1 || !errors.Is(errs[0], ErrTooManyFields) {
+		t.Errorf("expected only the rejection, got %v", errs)
+	}
+
+	unheard := NewSignal("test.emit.collect.unheard", "Test emit collect without listeners")
+	if errs := c.EmitCollect(context.Background(), unheard); errs != nil {
+		t.Errorf("expected no errors without listeners, got %v", errs)
+	}
+}
@@ -0,0 +1,14 @@
+package capitan
+
+import "math/rand/v2"
+
+// sampledOut reports whether an event on signal should be discarded by
+// WithSampling, recording the drop if so.
+func (c *Capitan) sampledOut(signal Signal) bool {
+	rate, ok := c.sampling[signal]
+	if !ok || rate >= 1 || rand.Float64() < rate {
+		return false
+	}
+	c.reportDrop(signal, DropReasonSampled)
+	return true
+}
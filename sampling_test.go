@@ -0,0 +1,56 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+// TestWithSampling verifies roughly rate of events are kept and the rest counted as drops.
+func TestWithSampling(t *testing.T) {
+	sampled := NewSignal("test.sampling", "Test sampling signal")
+	full := NewSignal("test.sampling.full", "Test sampling full signal")
+	c := New(WithSyncMode(), WithSampling(sampled, 0.1))
+	defer c.Shutdown()
+
+	kept, fullKept := 0, 0
+	c.Hook(sampled, func(_ context.Context, _ *Event) { kept++ })
+	c.Hook(full, func(_ context.Context, _ *Event) { fullKept++ })
+
+	const n = 10000
+	for i := 0; i < n; i++ {
+		c.Emit(context.Background(), sampled)
+		c.Emit(context.Background(), full)
+	}
+
+	if kept < 700 || kept > 1300 {
+		t.Errorf("expected about 10%% of %d events kept, got %d", n, kept)
+	}
+	if fullKept != n {
+		t.Errorf("expected unsampled signal untouched, got %d", fullKept)
+	}
+	if dropped := c.Stats().DropCounts[DropReasonSampled]; dropped != uint64(n-kept) {
+		t.Errorf("expected %d sampled drops, got %d", n-kept, dropped)
+	}
+}
+
+// TestWithSamplingBounds verifies rates of 0 and 1 drop everything and nothing.
+func TestWithSamplingBounds(t *testing.T) {
+	none := NewSignal("test.sampling.none", "Test sampling none signal")
+	all := NewSignal("test.sampling.all", "Test sampling all signal")
+	c := New(WithSyncMode(), WithSampling(none, 0), WithSampling(all, 1.5))
+	defer c.Shutdown()
+
+	counts := map[Signal]int{}
+	for _, sig := range []Signal{none, all} {
+		c.Hook(sig, func(_ context.Context, e *Event) { counts[e.Signal()]++ })
+	}
+	for i := 0; i < 100; i++ {
+		c.Emit(context.Background(), none)
+	}
+	c.EmitBatch(context.Background(), all, make([][]Field, 100))
+	c.EmitBatch(context.Background(), none, make([][]Field, 100))
+
+	if counts[none] != 0 || counts[all] != 100 {
+		t.Errorf("expected 0 and 100 deliveries, got %d and %d", counts[none], counts[all])
+	}
+}
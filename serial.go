@@ -0,0 +1,176 @@
+package capitan
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// serialQueue is the single ordered queue behind an ObserveSerial observer.
+type serialQueue struct {
+	observer *Observer
+	listener *Listener // carries the callback through invokeListener
+	events   chan *Event
+	done     chan struct{} // closed to drain and stop the delivery goroutine
+	stopOnce sync.Once
+	syncMu   sync.Mutex // serializes delivery in sync mode
+}
+
+// stop signals the delivery goroutine to drain its queue and exit.
+func (q *serialQueue) stop() {
+	q.stopOnce.Do(func() { close(q.done) })
+}
+
+// watches reports whether the queue's observer receives signal.
+func (q *serialQueue) watches(signal Signal) bool {
+	if q.observer.signals == nil {
+		return true
+	}
+	_, ok := q.observer.signals[signal]
+	return ok
+}
+
+// ObserveSerial registers an observer on the default instance that receives
+// events from every watched signal in emit order.
+func ObserveSerial(callback EventCallback, signals ...Signal) *Observer {
+	return defaultInstance().ObserveSerial(callback, signals...)
+}
+
+// ObserveSerial registers an observer that receives events from all watched
+// signals (all signals if none are given) through one dedicated queue and
+// goroutine, so callbacks run one at a time in the order the events were
+// emitted, across signals. Regular observers are fed by each signal's own
+// worker and see events from different signals in no particular order.
+// Use it for audit trails and other consumers that need one serialized stream.
+//
+// The trade-off is throughput: every watched emit is copied onto the shared
+// queue at emit time, and a slow callback holds up all watched signals. When
+// the queue is full, Emit blocks until there is room, the emitter's context
+// is canceled, or the instance shuts down. The queue holds as many events as
+// a signal's queue, so the callback must not emit on watched signals or it
+// may wait on itself. Serial observers don't count as listeners: signals they
+// watch still need a listener to be delivered to anyone else.
+// Call Close on the returned Observer to stop it; queued events are delivered first.
+func (c *Capitan) ObserveSerial(callback EventCallback, signals ...Signal) *Observer {
+	o := &Observer{
+		callback: callback,
+		capitan:  c,
+		active:   true,
+	}
+	if len(signals) > 0 {
+		o.signals = make(map[Signal]struct{}, len(signals))
+		for _, sig := range signals {
+			o.signals[sig] = struct{}{}
+		}
+	}
+
+	q := &serialQueue{
+		observer: o,
+		listener: &Listener{callback: callback, capitan: c, observer: o},
+		events:   make(chan *Event, c.bufferSize),
+		done:     make(chan struct{}),
+	}
+	// Set before the queue is published, so Close always finds it
+	o.onClose = func() {
+		c.mu.Lock()
+		c.serials = slices.DeleteFunc(c.serials, func(other *serialQueue) bool { return other == q })
+		c.hasSerials.Store(len(c.serials) > 0)
+		c.mu.Unlock()
+		q.stop()
+	}
+
+	c.mu.Lock()
+	c.serials = append(c.serials, q)
+	c.hasSerials.Store(true)
+	c.mu.Unlock()
+
+	if !c.syncMode {
+		c.wg.Add(1)
+		go c.runSerial(q)
+	}
+	return o
+}
+
+// feedSerial copies an emitted event onto every serial observer watching its signal.
+func (c *Capitan) feedSerial(ctx context.Context, signal Signal, severity Severity, timestamp time.Time, callerFile string, callerLine int, cause *eventCause, fields []Field) {
+	c.mu.RLock()
+	var queues []*serialQueue
+	for _, q := range c.serials {
+		if q.watches(signal) {
+			queues = append(queues, q)
+		}
+	}
+	c.mu.RUnlock()
+
+	for _, q := range queues {
+		event := c.newEvent(c.eventContext(ctx), signal, severity, timestamp, fields...)
+		event.callerFile, event.callerLine = callerFile, callerLine
+		event.cause = cause
+
+		if c.syncMode {
+			q.syncMu.Lock()
+			c.deliverSerial(q, event)
+			q.syncMu.Unlock()
+			continue
+		}
+
+		c.serialPending.Add(1)
+		select {
+		case q.events <- event:
+		case <-q.done:
+			// Observer closed; it no longer wants events
+			c.serialPending.Add(-1)
+			c.pool.Put(event)
+		case <-ctx.Done():
+			c.serialPending.Add(-1)
+			c.dropEvent(event, DropReasonCanceled)
+		case <-c.shutdown:
+			c.serialPending.Add(-1)
+			c.dropEvent(event, DropReasonShutdown)
+		}
+	}
+}
+
+// runSerial is the delivery goroutine for a serial observer.
+func (c *Capitan) runSerial(q *serialQueue) {
+	defer c.wg.Done()
+
+	deliver := func(event *Event) {
+		c.deliverSerial(q, event)
+		c.serialPending.Add(-1)
+	}
+	drain := func() {
+		for {
+			select {
+			case event := <-q.events:
+				deliver(event)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case event := <-q.events:
+			deliver(event)
+		case <-q.done:
+			drain()
+			return
+		case <-c.shutdown:
+			drain()
+			return
+		}
+	}
+}
+
+// deliverSerial invokes the serial observer's callback and releases the event.
+func (c *Capitan) deliverSerial(q *serialQueue, event *Event) {
+	if event.ctx.Err() != nil && !c.processCanceled {
+		c.dropEvent(event, DropReasonCanceled)
+		return
+	}
+	c.invokeListener(event.signal, q.listener, event)
+	c.pool.Put(event)
+}
@@ -2,7 +2,12 @@ package capitan
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var (
@@ -12,37 +17,124 @@ var (
 
 // Capitan is an event coordination system.
 type Capitan struct {
-	registry     map[Signal][]*Listener
-	workers      map[Signal]*workerState
-	observers    []*Observer
-	shutdown     chan struct{}
-	shutdownOnce sync.Once
-	wg           sync.WaitGroup
-	mu           sync.RWMutex
-	bufferSize   int
-	panicHandler PanicHandler
-	syncMode     bool
-	emitCounts   map[Signal]uint64
-	fieldSchemas map[Signal][]Key
+	registry            map[Signal][]*Listener
+	workers             map[Signal]*workerState
+	pool                *sync.Pool // events are always returned to the pool they came from
+	observers           []*Observer
+	shutdown            chan struct{}
+	shutdownOnce        sync.Once
+	closed              atomic.Bool // set once Shutdown begins
+	wg                  sync.WaitGroup
+	listenerWG          sync.WaitGroup // buffered listener goroutines
+	mu                  sync.RWMutex
+	bufferSize          int
+	panicHandler        PanicHandler
+	slowThreshold       time.Duration
+	slowHandler         SlowListenerHandler
+	syncMode            bool
+	callerInfo          bool
+	detachContext       bool
+	clock               func() time.Time
+	timers              Clock    // creates timers; set with clock by WithTimeSource
+	minSeverity         Severity // empty = no filtering
+	severityRanks       map[Severity]int
+	inFlight            atomic.Int64
+	inFlightCap         chan struct{} // nil = unbounded
+	pendingRetries      atomic.Int64  // retries waiting to be re-enqueued
+	bufferedPending     atomic.Int64  // events queued for or running in buffered listeners
+	metrics             *InMemoryMetrics
+	collector           MetricsCollector
+	detailedStats       bool
+	trackQueueDepth     bool
+	serials             []*serialQueue // ObserveSerial observers; guarded by mu
+	hasSerials          atomic.Bool    // len(serials) > 0, checked without locking on emit
+	serialPending       atomic.Int64   // events queued for or being delivered to serial observers
+	canceledCounts      map[Signal]uint64
+	canceledHandler     func(signal Signal, fields []Field)
+	processCanceled     bool
+	cancelBetween       bool
+	listenerConcurrency map[Signal]int // set only by options; read without locking
+	dropHandler         DropHandler
+	dlq                 *deadLetterQueue // nil = disabled
+	emitTimeout         time.Duration
+	idleTimeout         time.Duration
+	errorHandler        ErrorHandler
+	errorPolicy         ErrorPolicy
+	maxRetries          int
+	retryBackoff        func(attempt int) time.Duration
+	fieldSchemas        map[Signal][]Key
+	strictStructs       bool
+	signalTags          map[Signal][]string
+	maxFields           int
+	maxBytesSize        int
+	maxEmitDepth        int
+	preEmit             map[Signal][]PreEmitFunc // Set only by options; read without locking
+	enrichers           []Enricher               // Set only by options; read without locking
+	interceptors        []EmitInterceptor        // Set only by options; read without locking
+	correlate           bool                     // Set only by options; read without locking
+	fallback            atomic.Pointer[Listener] // Set by HookDefault under mu; loaded without locking
+	forwards            map[Signal][]Signal
+	emitThresholds      map[Signal][]emitThreshold
+	hasThresholds       atomic.Bool         // Skips threshold lookups until one is registered
+	redacted            map[string]struct{} // Set only by options; shared read-only with events
+	sampling            map[Signal]float64  // Set only by options; read without locking
+	dupPolicy           DuplicateFieldPolicy
+	defaultFields       atomic.Pointer[[]Field]  // copy-on-write; replaced under mu
+	history             map[Signal]*eventHistory // Set only by options; entries locked internally
+	replayOrder         ReplayOrder
+	stuckAfter          time.Duration
+	maxEventAge         time.Duration
+
+	// optionErrs collects invalid options while NewValidated applies them.
+	optionErrs []error
 }
 
 // New creates a new Capitan instance with optional configuration.
-// If no options are provided, sensible defaults are used (bufferSize=16, no panic handler).
+// If no options are provided, sensible defaults are used (bufferSize=16, no panic handler, time.Now clock).
 func New(opts ...Option) *Capitan {
 	c := &Capitan{
-		registry:     make(map[Signal][]*Listener),
-		workers:      make(map[Signal]*workerState),
-		shutdown:     make(chan struct{}),
-		bufferSize:   16, // default buffer size
-		emitCounts:   make(map[Signal]uint64),
-		fieldSchemas: make(map[Signal][]Key),
+		registry:       make(map[Signal][]*Listener),
+		workers:        make(map[Signal]*workerState),
+		pool:           newEventPool(),
+		shutdown:       make(chan struct{}),
+		bufferSize:     16, // default buffer size
+		clock:          time.Now,
+		timers:         systemClock{},
+		severityRanks:  severityRanks(defaultSeverityOrder),
+		metrics:        NewInMemoryMetrics(),
+		canceledCounts: make(map[Signal]uint64),
+		errorPolicy:    ErrorPolicyDeadLetter,
+		maxRetries:     defaultMaxRetries,
+		fieldSchemas:   make(map[Signal][]Key),
+		signalTags:     make(map[Signal][]string),
+		forwards:       make(map[Signal][]Signal),
+		stuckAfter:     defaultStuckAfter,
+		maxEventAge:    defaultMaxEventAge,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.optionErrs = nil
 	return c
 }
 
+// NewValidated creates a Capitan instance like New, but fails instead of
+// silently ignoring or adjusting invalid options: negative sizes, nil
+// handlers, unknown policies, and options that have no effect together, such
+// as WithMaxInFlight in sync mode. The returned error wraps ErrInvalidOption
+// and lists every problem found.
+func NewValidated(opts ...Option) (*Capitan, error) {
+	c := New()
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.validateOptions()
+	if err := errors.Join(c.optionErrs...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 // defaultInstance returns the default Capitan instance, creating it if necessary.
 func defaultInstance() *Capitan {
 	defaultOnce.Do(func() {
@@ -50,6 +142,8 @@ func defaultInstance() *Capitan {
 		opts := defaultOptions
 		defaultOptMu.Unlock()
 		defaultCapitan = New(opts...)
+		// The module-level API shares the package-wide event pool
+		defaultCapitan.pool = eventPool
 	})
 	return defaultCapitan
 }
@@ -71,15 +165,145 @@ func (c *Capitan) Hook(signal Signal, callback EventCallback) *Listener {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	return c.hookLocked(signal, callback)
+}
+
+// HookNamed registers a named callback for the given signal on the default instance.
+func HookNamed(signal Signal, name string, callback EventCallback) *Listener {
+	return defaultInstance().HookNamed(signal, name, callback)
+}
+
+// HookNamed registers a callback for the given signal like Hook, labeled with
+// name for diagnostics. The name is reported in Stats.ListenerNames, Dump,
+// slow-listener callbacks, and dead-letter entries, in place of the callback's
+// function name. Names need not be unique.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookNamed(signal Signal, name string, callback EventCallback) *Listener {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.register(&Listener{
+		signal:   signal,
+		callback: callback,
+		capitan:  c,
+		name:     name,
+	})
+}
+
+// HookCtx registers a callback on the default instance that is removed when ctx is done.
+func HookCtx(ctx context.Context, signal Signal, callback EventCallback) *Listener {
+	return defaultInstance().HookCtx(ctx, signal, callback)
+}
+
+// HookCtx registers a callback for the given signal that is closed
+// automatically once ctx is canceled or times out, which suits listeners
+// scoped to a request or connection. Calling Close earlier is still allowed
+// and releases the goroutine waiting on ctx.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookCtx(ctx context.Context, signal Signal, callback EventCallback) *Listener {
 	listener := &Listener{
 		signal:   signal,
 		callback: callback,
 		capitan:  c,
+		done:     make(chan struct{}),
+	}
+
+	c.mu.Lock()
+	c.register(listener)
+	c.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			listener.Close()
+		case <-listener.done:
+		}
+	}()
+	return listener
+}
+
+// HookE registers an error-returning handler for the given signal on the default instance.
+func HookE(signal Signal, handler EventHandler) *Listener {
+	return defaultInstance().HookE(signal, handler)
+}
+
+// HookE registers an error-returning handler for the given signal.
+// A non-nil error is handled according to the instance's ErrorPolicy:
+// reported to the error handler, retried, or ignored.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookE(signal Signal, handler EventHandler) *Listener {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.register(&Listener{
+		signal:  signal,
+		handler: handler,
+		capitan: c,
+	})
+}
+
+// HookWithRetry registers an error-returning handler with its own retry
+// policy on the default instance.
+func HookWithRetry(signal Signal, handler EventHandler, policy RetryPolicy) *Listener {
+	return defaultInstance().HookWithRetry(signal, handler, policy)
+}
+
+// HookWithRetry registers an error-returning handler whose failures are
+// retried according to policy, regardless of the instance's ErrorPolicy.
+// Retries reach only this handler and wait out their backoff off the worker.
+// Once attempts are exhausted the error goes to the error handler and, if
+// configured, the dead letter queue.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookWithRetry(signal Signal, handler EventHandler, policy RetryPolicy) *Listener {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.register(&Listener{
+		signal:      signal,
+		handler:     handler,
+		capitan:     c,
+		retryPolicy: &policy,
+	})
+}
+
+// hookLocked registers a listener for the signal.
+// Must be called while holding c.mu write lock.
+func (c *Capitan) hookLocked(signal Signal, callback EventCallback) *Listener {
+	return c.register(&Listener{
+		signal:   signal,
+		callback: callback,
+		capitan:  c,
+	})
+}
+
+// hookInternal registers a helper's listener, which doesn't count against
+// HookExclusive.
+func (c *Capitan) hookInternal(signal Signal, callback EventCallback) *Listener {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.register(&Listener{
+		signal:   signal,
+		callback: callback,
+		capitan:  c,
+		internal: true,
+	})
+}
+
+// register adds a listener to the registry, attaching observers for new signals.
+// Must be called while holding c.mu write lock.
+func (c *Capitan) register(listener *Listener) *Listener {
+	signal := listener.signal
+
+	// Closed instances have released their registry
+	if c.registry == nil {
+		return listener
 	}
 
 	// Check if this is a new signal
 	_, exists := c.registry[signal]
 	c.registry[signal] = append(c.registry[signal], listener)
+	listener.active.Store(true)
 
 	// If new signal, attach to all active observers
 	if !exists {
@@ -89,16 +313,57 @@ func (c *Capitan) Hook(signal Signal, callback EventCallback) *Listener {
 	return listener
 }
 
+// HookExclusive registers a callback for the given signal on the default instance,
+// failing if the signal already has a listener.
+func HookExclusive(signal Signal, callback EventCallback) (*Listener, error) {
+	return defaultInstance().HookExclusive(signal, callback)
+}
+
+// HookExclusive registers a callback for the given signal, failing with
+// ErrListenerExists if the signal already has a listener registered via Hook.
+// Observer listeners, and those hooked by helpers such as Route, FanIn,
+// Await, Events, Join, Aggregate, and PublishTo, don't count against
+// exclusivity.
+// Use it to guard singleton handlers against double registration.
+func (c *Capitan) HookExclusive(signal Signal, callback EventCallback) (*Listener, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, l := range c.registry[signal] {
+		if l.observer == nil && !l.internal {
+			return nil, fmt.Errorf("%w: %s", ErrListenerExists, signal.Name())
+		}
+	}
+
+	return c.hookLocked(signal, callback), nil
+}
+
 // Emit dispatches an event with Info severity on the default instance.
 func Emit(ctx context.Context, signal Signal, fields ...Field) {
 	defaultInstance().Emit(ctx, signal, fields...)
 }
 
+// EmitChecked dispatches an Info-severity event on the default instance,
+// returning the error if it is rejected before queueing.
+func EmitChecked(ctx context.Context, signal Signal, fields ...Field) error {
+	return defaultInstance().EmitChecked(ctx, signal, fields...)
+}
+
+// EmitBatch dispatches one Info-severity event per field set on the default instance.
+func EmitBatch(ctx context.Context, signal Signal, fieldSets [][]Field) {
+	defaultInstance().EmitBatch(ctx, signal, fieldSets)
+}
+
 // Debug dispatches an event with Debug severity on the default instance.
 func Debug(ctx context.Context, signal Signal, fields ...Field) {
 	defaultInstance().Debug(ctx, signal, fields...)
 }
 
+// EmitSeverity dispatches an event with an arbitrary severity on the default instance.
+func EmitSeverity(ctx context.Context, signal Signal, severity Severity, fields ...Field) {
+	defaultInstance().EmitSeverity(ctx, signal, severity, fields...)
+}
+
 // Info dispatches an event with Info severity on the default instance.
 func Info(ctx context.Context, signal Signal, fields ...Field) {
 	defaultInstance().Info(ctx, signal, fields...)
@@ -118,10 +383,22 @@ func Error(ctx context.Context, signal Signal, fields ...Field) {
 func (c *Capitan) unregister(listener *Listener) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.unregisterLocked(listener)
+}
+
+// unregisterLocked removes a listener from the registry.
+// Must be called while holding c.mu write lock.
+func (c *Capitan) unregisterLocked(listener *Listener) {
+	// The fallback listener is held apart from the registry
+	if c.fallback.CompareAndSwap(listener, nil) {
+		listener.active.Store(false)
+		return
+	}
 
 	listeners := c.registry[listener.signal]
 	for i, l := range listeners {
 		if l == listener {
+			listener.active.Store(false)
 			// Swap with last element and truncate (efficient removal)
 			lastIdx := len(listeners) - 1
 			listeners[i] = listeners[lastIdx]
@@ -142,31 +419,130 @@ func (c *Capitan) unregister(listener *Listener) {
 	}
 }
 
+// CloseSignal removes every listener for signal on the default instance.
+func CloseSignal(signal Signal) {
+	defaultInstance().CloseSignal(signal)
+}
+
+// CloseSignal tears down everything registered for signal in one call: all
+// its listeners, including those attached by observers, are closed; its
+// worker processes the events already queued and exits; and its emit counts,
+// canceled counts, field schema, and retained history are discarded. Observers stay open and
+// re-attach if the signal is seen again, so later emits behave as if the
+// signal were new. Blocks until the worker has exited, so it must not be
+// called from one of the signal's own listeners.
+func (c *Capitan) CloseSignal(signal Signal) {
+	// Let the worker deliver what is already queued while listeners remain
+	c.mu.Lock()
+	worker, hasWorker := c.workers[signal]
+	if hasWorker {
+		close(worker.done)
+		delete(c.workers, signal)
+	}
+	c.mu.Unlock()
+	if hasWorker {
+		<-worker.exited
+	}
+
+	c.mu.Lock()
+	listeners := c.registry[signal]
+	delete(c.registry, signal)
+	for _, l := range listeners {
+		l.active.Store(false)
+	}
+	for _, obs := range c.observers {
+		obs.mu.Lock()
+		obs.listeners = slices.DeleteFunc(obs.listeners, func(l *Listener) bool {
+			return l.signal == signal
+		})
+		obs.mu.Unlock()
+	}
+	// A worker started by an emit during the drain has no listeners left
+	if worker, exists := c.workers[signal]; exists {
+		close(worker.done)
+		delete(c.workers, signal)
+	}
+	delete(c.canceledCounts, signal)
+	delete(c.fieldSchemas, signal)
+	c.mu.Unlock()
+
+	for _, l := range listeners {
+		l.release()
+	}
+	// The history stays configured for the signal's next listeners, but empty
+	if history := c.history[signal]; history != nil {
+		history.reset(c.pool)
+	}
+	c.metrics.releaseSignal(signal)
+}
+
 // Stats returns runtime metrics for the Capitan instance.
 // Provides visibility into active workers, queue depths, listener counts,
-// emit counts, and field schemas.
+// emit counts, observers, and field schemas.
 func (c *Capitan) Stats() Stats {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	stats := Stats{
-		ActiveWorkers:  len(c.workers),
-		QueueDepths:    make(map[Signal]int, len(c.workers)),
-		ListenerCounts: make(map[Signal]int, len(c.registry)),
-		EmitCounts:     make(map[Signal]uint64, len(c.emitCounts)),
-		FieldSchemas:   make(map[Signal][]Key, len(c.fieldSchemas)),
+		ActiveWorkers:       len(c.workers),
+		InFlight:            int(c.inFlight.Load()),
+		QueueDepths:         make(map[Signal]int, len(c.workers)),
+		QueueCapacities:     make(map[Signal]int, len(c.workers)),
+		QueueHighWatermarks: make(map[Signal]int, len(c.workers)),
+		ListenerCounts:      make(map[Signal]int, len(c.registry)),
+		EmitCounts:          c.metrics.Emitted(),
+		ProcessedCounts:     c.metrics.Succeeded(),
+		SeverityCounts:      c.metrics.Severities(),
+		CanceledCounts:      make(map[Signal]uint64, len(c.canceledCounts)),
+		DropCounts:          c.metrics.Dropped(),
+		FieldSchemas:        make(map[Signal][]Key, len(c.fieldSchemas)),
 	}
 
 	for signal, worker := range c.workers {
 		stats.QueueDepths[signal] = len(worker.events)
+		stats.QueueCapacities[signal] = cap(worker.events)
+		stats.QueueHighWatermarks[signal] = int(worker.highWater.Load())
 	}
 
 	for signal, listeners := range c.registry {
 		stats.ListenerCounts[signal] = len(listeners)
+		for _, l := range listeners {
+			if l.name == "" {
+				continue
+			}
+			if stats.ListenerNames == nil {
+				stats.ListenerNames = make(map[Signal][]string)
+			}
+			stats.ListenerNames[signal] = append(stats.ListenerNames[signal], l.name)
+		}
+	}
+
+	for signal, count := range c.canceledCounts {
+		stats.CanceledCounts[signal] = count
+	}
+
+	if c.trackQueueDepth {
+		stats.MaxQueueDepth = make(map[Signal]int, len(c.workers))
+		stats.P95QueueDepth = make(map[Signal]int, len(c.workers))
+		for signal, worker := range c.workers {
+			stats.MaxQueueDepth[signal] = int(worker.depths.max.Load())
+			stats.P95QueueDepth[signal] = worker.depths.p95()
+		}
+	}
+
+	if c.detailedStats {
+		stats.SignalSeverityCounts = c.metrics.SignalSeverities()
 	}
 
-	for signal, count := range c.emitCounts {
-		stats.EmitCounts[signal] = count
+	stats.SignalTags = make(map[Signal][]string, len(c.signalTags))
+	for signal, tags := range c.signalTags {
+		stats.SignalTags[signal] = slices.Clone(tags)
+	}
+
+	stats.ObserverCount = len(c.observers)
+	stats.Observers = make([]ObserverStats, 0, len(c.observers))
+	for _, obs := range c.observers {
+		stats.Observers = append(stats.Observers, obs.stats())
 	}
 
 	for signal, keys := range c.fieldSchemas {
@@ -179,6 +555,64 @@ func (c *Capitan) Stats() Stats {
 	return stats
 }
 
+// ObserverCount returns the number of active observers.
+func (c *Capitan) ObserverCount() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.observers)
+}
+
+// ListenerCount returns the number of listeners for signal on the default instance.
+func ListenerCount(signal Signal) int {
+	return defaultInstance().ListenerCount(signal)
+}
+
+// HasListeners reports whether signal has any listeners on the default instance.
+func HasListeners(signal Signal) bool {
+	return defaultInstance().HasListeners(signal)
+}
+
+// ListenerCount returns the number of listeners an emit on signal would reach,
+// including observers. Observers attach to a signal when it is first seen, so
+// for a signal not yet emitted or hooked, the observers that would attach are
+// counted.
+func (c *Capitan) ListenerCount(signal Signal) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if listeners, exists := c.registry[signal]; exists {
+		return len(listeners)
+	}
+	count := 0
+	for _, obs := range c.observers {
+		obs.mu.Lock()
+		if obs.active {
+			if _, ok := obs.signals[signal]; ok || obs.signals == nil {
+				count++
+			}
+		}
+		obs.mu.Unlock()
+	}
+	return count
+}
+
+// HasListeners reports whether an emit on signal would reach any listener.
+// Use it to skip expensive field preparation when no one is listening.
+func (c *Capitan) HasListeners(signal Signal) bool {
+	return c.ListenerCount(signal) > 0
+}
+
+// Flush waits until the default instance has no queued or in-flight events.
+func Flush(ctx context.Context) error {
+	return defaultInstance().Flush(ctx)
+}
+
+// IsShutdown reports whether the default instance has been shut down.
+// Module-level emits after Shutdown are dropped with DropReasonShutdown.
+func IsShutdown() bool {
+	return defaultInstance().IsShutdown()
+}
+
 // Shutdown gracefully stops all worker goroutines on the default instance.
 func Shutdown() {
 	defaultInstance().Shutdown()
@@ -2,6 +2,7 @@ package capitan
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -479,3 +480,441 @@ func TestDefault(t *testing.T) {
 		t.Error("Default() should return the same instance")
 	}
 }
+
+func TestHookEDeadLetterDefault(t *testing.T) {
+	var gotSignal Signal
+	var gotErr error
+	c := New(WithSyncMode(), WithErrorHandler(func(signal Signal, err error) {
+		gotSignal = signal
+		gotErr = err
+	}))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hooke.deadletter", "Test HookE dead letter signal")
+	testErr := errors.New("write failed")
+
+	c.HookE(sig, func(_ context.Context, _ *Event) error {
+		return testErr
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if gotSignal != sig || !errors.Is(gotErr, testErr) {
+		t.Errorf("expected error handler with %v/%v, got %v/%v", sig, testErr, gotSignal, gotErr)
+	}
+}
+
+func TestHookERetry(t *testing.T) {
+	var mu sync.Mutex
+	var handled []error
+
+	c := New(
+		WithErrorPolicy(ErrorPolicyRetry),
+		WithMaxRetries(3),
+		WithErrorHandler(func(_ Signal, err error) {
+			mu.Lock()
+			handled = append(handled, err)
+			mu.Unlock()
+		}),
+	)
+
+	sig := NewSignal("test.hooke.retry", "Test HookE retry signal")
+
+	var attempts, plainCalls int
+	c.HookE(sig, func(_ context.Context, _ *Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		mu.Lock()
+		plainCalls++
+		mu.Unlock()
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	c.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if plainCalls != 1 {
+		t.Errorf("expected retries to skip other listeners, got %d plain calls", plainCalls)
+	}
+	if len(handled) != 0 {
+		t.Errorf("expected no dead-lettered errors, got %v", handled)
+	}
+}
+
+func TestHookERetryExhausted(t *testing.T) {
+	var handled []error
+	c := New(
+		WithSyncMode(),
+		WithErrorPolicy(ErrorPolicyRetry),
+		WithMaxRetries(2),
+		WithErrorHandler(func(_ Signal, err error) {
+			handled = append(handled, err)
+		}),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hooke.exhausted", "Test HookE exhausted signal")
+
+	attempts := 0
+	c.HookE(sig, func(_ context.Context, _ *Event) error {
+		attempts++
+		return errors.New("permanent")
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if attempts != 3 {
+		t.Errorf("expected 1 attempt plus 2 retries, got %d", attempts)
+	}
+	if len(handled) != 1 {
+		t.Errorf("expected 1 error after retries exhausted, got %d", len(handled))
+	}
+}
+
+func TestHookEIgnore(t *testing.T) {
+	called := false
+	c := New(
+		WithSyncMode(),
+		WithErrorPolicy(ErrorPolicyIgnore),
+		WithErrorHandler(func(_ Signal, _ error) { called = true }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hooke.ignore", "Test HookE ignore signal")
+	c.HookE(sig, func(_ context.Context, _ *Event) error {
+		return errors.New("ignored")
+	})
+
+	c.Emit(context.Background(), sig)
+
+	if called {
+		t.Error("expected error handler not to be called with ErrorPolicyIgnore")
+	}
+}
+
+func TestHookEWithRetryBackoff(t *testing.T) {
+	var mu sync.Mutex
+	var attempts, delays []int
+	dead := make(chan error, 1)
+
+	c := New(
+		WithRetry(3, func(attempt int) time.Duration {
+			mu.Lock()
+			delays = append(delays, attempt)
+			mu.Unlock()
+			return 10 * time.Millisecond
+		}),
+		WithErrorHandler(func(_ Signal, err error) { dead <- err }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hooke.backoff", "Test HookE backoff signal")
+	other := make(chan struct{}, 1)
+
+	c.HookE(sig, func(_ context.Context, e *Event) error {
+		mu.Lock()
+		attempts = append(attempts, e.Attempt())
+		mu.Unlock()
+		if e.Get(NewStringKey("other")) != nil {
+			other <- struct{}{}
+			return nil
+		}
+		if e.Attempt() == 1 {
+			// The worker must stay free for other events during the backoff
+			c.Emit(context.Background(), sig, NewStringKey("other").Field("x"))
+		}
+		return errors.New("failing")
+	})
+
+	start := time.Now()
+	c.Emit(context.Background(), sig)
+
+	select {
+	case <-other:
+	case <-time.After(time.Second):
+		t.Fatal("worker blocked during backoff")
+	}
+	if elapsed := time.Since(start); elapsed >= 10*time.Millisecond {
+		t.Errorf("other event waited for backoff: %v", elapsed)
+	}
+
+	select {
+	case <-dead:
+	case <-time.After(time.Second):
+		t.Fatal("expected dead-letter after attempts exhausted")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(delays) != 2 || delays[0] != 1 || delays[1] != 2 {
+		t.Errorf("expected backoff for attempts 1 and 2, got %v", delays)
+	}
+	// The failing event is delivered 3 times; the "other" event once
+	failing := 0
+	for _, a := range attempts {
+		if a > 1 {
+			failing++
+		}
+	}
+	if failing != 2 || attempts[len(attempts)-1] != 3 {
+		t.Errorf("expected redeliveries with attempts 2 and 3, got %v", attempts)
+	}
+}
+
+func TestHookEWithRetryShutdownDuringBackoff(t *testing.T) {
+	drops := make(chan DropReason, 1)
+	c := New(
+		WithRetry(2, func(int) time.Duration { return time.Hour }),
+		WithDropHandler(func(_ Signal, reason DropReason) { drops <- reason }),
+	)
+
+	sig := NewSignal("test.hooke.backoff.shutdown", "Test HookE backoff shutdown signal")
+	failed := make(chan struct{})
+	c.HookE(sig, func(_ context.Context, _ *Event) error {
+		close(failed)
+		return errors.New("failing")
+	})
+
+	c.Emit(context.Background(), sig)
+	<-failed
+
+	done := make(chan struct{})
+	go func() {
+		c.Shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("shutdown waited for backoff")
+	}
+	if reason := <-drops; reason != DropReasonShutdown {
+		t.Errorf("expected shutdown drop, got %v", reason)
+	}
+}
+
+func TestInstanceEventPools(t *testing.T) {
+	c1 := New()
+	c2 := New()
+	defer c1.Shutdown()
+	defer c2.Shutdown()
+
+	if c1.pool == c2.pool {
+		t.Error("expected each instance to own its event pool")
+	}
+	if c1.pool == eventPool || c2.pool == eventPool {
+		t.Error("expected dedicated instances not to use the shared pool")
+	}
+	if Default().pool != eventPool {
+		t.Error("expected the default instance to use the shared pool")
+	}
+
+	sig := NewSignal("test.pool.instance", "Test instance pool signal")
+	c1.Hook(sig, func(_ context.Context, _ *Event) {})
+	event := c1.newEvent(context.Background(), sig, SeverityInfo, time.Now())
+	clone := event.clone(c1.pool)
+	if clone == event || clone.Signal() != sig {
+		t.Error("expected clone to be a distinct event with the same signal")
+	}
+	c1.pool.Put(event)
+	c1.pool.Put(clone)
+}
+
+func TestHookWithRetry(t *testing.T) {
+	var handled []error
+	var mu sync.Mutex
+	c := New(WithErrorHandler(func(_ Signal, err error) {
+		mu.Lock()
+		handled = append(handled, err)
+		mu.Unlock()
+	}))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hookretry", "Test HookWithRetry signal")
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, Multiplier: 2, MaxBackoff: 10 * time.Millisecond}
+
+	var attempts []int
+	c.HookWithRetry(sig, func(_ context.Context, e *Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, e.Attempt())
+		if len(attempts) <= 2 {
+			return errors.New("transient")
+		}
+		return nil
+	}, policy)
+
+	c.Emit(context.Background(), sig)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(attempts) != 3 || attempts[2] != 3 {
+		t.Errorf("expected success on attempt 3, got %v", attempts)
+	}
+	if len(handled) != 0 {
+		t.Errorf("expected no errors reported, got %v", handled)
+	}
+}
+
+func TestHookWithRetryExhausted(t *testing.T) {
+	var handled []error
+	c := New(
+		WithSyncMode(),
+		WithErrorPolicy(ErrorPolicyIgnore), // per-listener policy takes precedence
+		WithDeadLetter(4),
+		WithErrorHandler(func(_ Signal, err error) { handled = append(handled, err) }),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hookretry.exhausted", "Test HookWithRetry exhausted signal")
+	calls := 0
+	c.HookWithRetry(sig, func(_ context.Context, _ *Event) error {
+		calls++
+		return errors.New("permanent")
+	}, RetryPolicy{MaxAttempts: 3})
+
+	c.Emit(context.Background(), sig)
+
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+	if len(handled) != 1 {
+		t.Errorf("expected 1 reported error, got %d", len(handled))
+	}
+	if dead := c.DeadLetters(); len(dead) != 1 || dead[0].Reason != DropReasonListenerError {
+		t.Errorf("expected 1 listener error dead letter, got %+v", dead)
+	}
+}
+
+func TestHookWithRetryContextCanceled(t *testing.T) {
+	drops := make(chan DropReason, 1)
+	c := New(WithDropHandler(func(_ Signal, reason DropReason) { drops <- reason }))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.hookretry.cancel", "Test HookWithRetry cancel signal")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	c.HookWithRetry(sig, func(_ context.Context, _ *Event) error {
+		calls++
+		cancel()
+		return errors.New("failing")
+	}, RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour})
+
+	c.Emit(ctx, sig)
+
+	select {
+	case reason := <-drops:
+		if reason != DropReasonCanceled {
+			t.Errorf("expected canceled drop, got %v", reason)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("retry did not respect context cancellation")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestCloseSignal(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.close.signal", "Test close signal")
+	other := NewSignal("test.close.signal.other", "Test close signal other")
+	key := NewStringKey("k")
+
+	var mu sync.Mutex
+	var processed int
+	first := c.Hook(sig, func(_ context.Context, _ *Event) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		processed++
+		mu.Unlock()
+	})
+	c.Hook(other, func(_ context.Context, _ *Event) {})
+	var observed int
+	obs := c.Observe(func(_ context.Context, e *Event) {
+		if e.Signal() == sig {
+			mu.Lock()
+			observed++
+			mu.Unlock()
+		}
+	})
+
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig, key.Field("v"))
+	}
+	c.Emit(context.Background(), other)
+	c.CloseSignal(sig)
+
+	mu.Lock()
+	if processed != 5 || observed != 5 {
+		t.Errorf("expected queued events drained before close, got %d processed, %d observed", processed, observed)
+	}
+	mu.Unlock()
+
+	if first.Active() || c.HasListeners(other) != true {
+		t.Error("expected only the closed signal's listeners to be removed")
+	}
+	stats := c.Stats()
+	if _, ok := stats.ListenerCounts[sig]; ok {
+		t.Error("expected registry entry removed")
+	}
+	if _, ok := stats.QueueDepths[sig]; ok {
+		t.Error("expected worker removed")
+	}
+	if _, ok := stats.EmitCounts[sig]; ok {
+		t.Error("expected emit count removed")
+	}
+	if _, ok := stats.FieldSchemas[sig]; ok {
+		t.Error("expected field schema removed")
+	}
+	if len(stats.Observers) != 1 || stats.Observers[0].AttachedSignals != 1 {
+		t.Errorf("expected observer attached to the remaining signal only, got %+v", stats.Observers)
+	}
+
+	// The signal starts over: the observer re-attaches and new hooks work
+	c.Emit(context.Background(), sig)
+	received := make(chan struct{}, 1)
+	c.Hook(sig, func(_ context.Context, _ *Event) { received <- struct{}{} })
+	c.Emit(context.Background(), sig)
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("expected new hook to receive events after CloseSignal")
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	if observed != 7 {
+		t.Errorf("expected observer to re-attach, got %d observed", observed)
+	}
+	mu.Unlock()
+
+	obs.Close()
+	if got := c.ListenerCount(sig); got != 1 {
+		t.Errorf("expected observer's re-attached listener closed with it, got %d listeners", got)
+	}
+}
@@ -0,0 +1,60 @@
+package capitan
+
+import "context"
+
+// defaultSeverityOrder ranks the built-in severities from lowest to highest.
+var defaultSeverityOrder = []Severity{SeverityDebug, SeverityInfo, SeverityWarn, SeverityError}
+
+// severityRanks maps each severity in order to its index.
+func severityRanks(order []Severity) map[Severity]int {
+	ranks := make(map[Severity]int, len(order))
+	for i, sev := range order {
+		ranks[sev] = i
+	}
+	return ranks
+}
+
+// severityAtLeast reports whether sev ranks at or above threshold in the instance's ordering.
+// Severities missing from the ordering are never filtered out.
+func (c *Capitan) severityAtLeast(sev, threshold Severity) bool {
+	sevRank, ok := c.severityRanks[sev]
+	if !ok {
+		return true
+	}
+	minRank, ok := c.severityRanks[threshold]
+	if !ok {
+		return true
+	}
+	return sevRank >= minRank
+}
+
+// HookSeverity registers a callback on the default instance that only
+// receives events on signal at or above minSev.
+func HookSeverity(signal Signal, minSev Severity, callback EventCallback) *Listener {
+	return defaultInstance().HookSeverity(signal, minSev, callback)
+}
+
+// HookSeverity registers a callback for the given signal that only receives
+// events ranked at or above minSev in the instance's severity order.
+// Severities missing from the order always pass, as with WithMinSeverity.
+// Other listeners on the signal are unaffected.
+// Returns a Listener that can be closed to unregister.
+func (c *Capitan) HookSeverity(signal Signal, minSev Severity, callback EventCallback) *Listener {
+	return c.Hook(signal, func(ctx context.Context, e *Event) {
+		if c.severityAtLeast(e.severity, minSev) {
+			callback(ctx, e)
+		}
+	})
+}
+
+// OnError registers a callback on the default instance for Error-severity
+// events on signal.
+func OnError(signal Signal, callback EventCallback) *Listener {
+	return defaultInstance().OnError(signal, callback)
+}
+
+// OnError registers a callback for Error-severity events on signal.
+// Shorthand for HookSeverity(signal, SeverityError, callback).
+func (c *Capitan) OnError(signal Signal, callback EventCallback) *Listener {
+	return c.HookSeverity(signal, SeverityError, callback)
+}
@@ -0,0 +1,148 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEmitSeverityCustomLevel(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.custom", "Test custom severity signal")
+	const severityTrace Severity = "TRACE"
+
+	var received Severity
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		received = e.Severity()
+	})
+
+	c.EmitSeverity(context.Background(), sig, severityTrace)
+
+	if received != severityTrace {
+		t.Errorf("expected severity %q, got %q", severityTrace, received)
+	}
+}
+
+func TestWithMinSeverity(t *testing.T) {
+	c := New(WithSyncMode(), WithMinSeverity(SeverityWarn))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.min", "Test min severity signal")
+
+	var received []Severity
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		received = append(received, e.Severity())
+	})
+
+	c.Debug(context.Background(), sig)
+	c.Info(context.Background(), sig)
+	c.Warn(context.Background(), sig)
+	c.Error(context.Background(), sig)
+
+	if len(received) != 2 || received[0] != SeverityWarn || received[1] != SeverityError {
+		t.Errorf("expected [WARN ERROR], got %v", received)
+	}
+}
+
+// TestWithMinSeverityBatch verifies EmitBatch's Info events are filtered too.
+func TestWithMinSeverityBatch(t *testing.T) {
+	c := New(WithSyncMode(), WithMinSeverity(SeverityError))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.min.batch", "Test min severity batch signal")
+
+	var calls int
+	c.Hook(sig, func(context.Context, *Event) { calls++ })
+
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, nil})
+
+	if calls != 0 {
+		t.Errorf("expected batch Info events below the minimum dropped, got %d", calls)
+	}
+}
+
+func TestWithSeverityOrder(t *testing.T) {
+	const (
+		severityTrace Severity = "TRACE"
+		severityFatal Severity = "FATAL"
+	)
+
+	c := New(
+		WithSyncMode(),
+		WithSeverityOrder([]Severity{severityTrace, SeverityDebug, SeverityInfo, SeverityWarn, SeverityError, severityFatal}),
+		WithMinSeverity(SeverityError),
+	)
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.order", "Test severity order signal")
+
+	var received []Severity
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		received = append(received, e.Severity())
+	})
+
+	c.EmitSeverity(context.Background(), sig, severityTrace)
+	c.Warn(context.Background(), sig)
+	c.Error(context.Background(), sig)
+	c.EmitSeverity(context.Background(), sig, severityFatal)
+
+	if len(received) != 2 || received[0] != SeverityError || received[1] != severityFatal {
+		t.Errorf("expected [ERROR FATAL], got %v", received)
+	}
+}
+
+func TestMinSeverityUnrankedPasses(t *testing.T) {
+	c := New(WithSyncMode(), WithMinSeverity(SeverityError))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.unranked", "Test unranked severity signal")
+
+	calls := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		calls++
+	})
+
+	c.EmitSeverity(context.Background(), sig, "AUDIT")
+
+	if calls != 1 {
+		t.Errorf("expected unranked severity to pass the filter, got %d calls", calls)
+	}
+}
+
+func TestHookSeverity(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.severity.hook", "Test hook severity signal")
+
+	var warnAndUp, errorsOnly, all, observed []Severity
+	c.HookSeverity(sig, SeverityWarn, func(_ context.Context, e *Event) {
+		warnAndUp = append(warnAndUp, e.Severity())
+	})
+	c.OnError(sig, func(_ context.Context, e *Event) {
+		errorsOnly = append(errorsOnly, e.Severity())
+	})
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		all = append(all, e.Severity())
+	})
+	obs := c.Observe(func(_ context.Context, e *Event) {
+		observed = append(observed, e.Severity())
+	}, sig)
+	defer obs.Close()
+
+	c.Debug(context.Background(), sig)
+	c.Info(context.Background(), sig)
+	c.Warn(context.Background(), sig)
+	c.Error(context.Background(), sig)
+
+	if len(warnAndUp) != 2 || warnAndUp[0] != SeverityWarn || warnAndUp[1] != SeverityError {
+		t.Errorf("expected [WARN ERROR], got %v", warnAndUp)
+	}
+	if len(errorsOnly) != 1 || errorsOnly[0] != SeverityError {
+		t.Errorf("expected [ERROR], got %v", errorsOnly)
+	}
+	if len(all) != 4 || len(observed) != 4 {
+		t.Errorf("expected other listeners and observers to see every event, got %v and %v", all, observed)
+	}
+}
@@ -0,0 +1,48 @@
+package capitan
+
+import (
+	"log/slog"
+	"time"
+)
+
+// FieldToSlogAttr converts a field into a slog.Attr using the typed constructor
+// for its variant (slog.String, slog.Int64, slog.Duration, ...).
+// Custom variants, byte slices, and errors fall back to slog.Any, and values
+// implementing LogValuer are logged as their LogValue.
+// Returns an empty Attr for a nil field.
+func FieldToSlogAttr(f Field) slog.Attr {
+	if f == nil || f.Key() == nil {
+		return slog.Attr{}
+	}
+	if value, ok := logValue(f); ok {
+		return slog.Any(f.Key().Name(), value)
+	}
+	v := slogVisitor{}
+	visitField(&v, f.Key().Name(), f)
+	return v.attr
+}
+
+// slogVisitor is a FieldVisitor that captures a single slog.Attr.
+type slogVisitor struct {
+	attr slog.Attr
+}
+
+func (v *slogVisitor) String(name string, value string) { v.attr = slog.String(name, value) }
+func (v *slogVisitor) Int(name string, value int)       { v.attr = slog.Int(name, value) }
+func (v *slogVisitor) Int32(name string, value int32)   { v.attr = slog.Int64(name, int64(value)) }
+func (v *slogVisitor) Int64(name string, value int64)   { v.attr = slog.Int64(name, value) }
+func (v *slogVisitor) Uint(name string, value uint)     { v.attr = slog.Uint64(name, uint64(value)) }
+func (v *slogVisitor) Uint32(name string, value uint32) { v.attr = slog.Uint64(name, uint64(value)) }
+func (v *slogVisitor) Uint64(name string, value uint64) { v.attr = slog.Uint64(name, value) }
+func (v *slogVisitor) Float32(name string, value float32) {
+	v.attr = slog.Float64(name, float64(value))
+}
+func (v *slogVisitor) Float64(name string, value float64) { v.attr = slog.Float64(name, value) }
+func (v *slogVisitor) Bool(name string, value bool)       { v.attr = slog.Bool(name, value) }
+func (v *slogVisitor) Time(name string, value time.Time)  { v.attr = slog.Time(name, value) }
+func (v *slogVisitor) Duration(name string, value time.Duration) {
+	v.attr = slog.Duration(name, value)
+}
+func (v *slogVisitor) Bytes(name string, value []byte) { v.attr = slog.Any(name, value) }
+func (v *slogVisitor) Error(name string, value error)  { v.attr = slog.Any(name, value) }
+func (v *slogVisitor) Default(name string, value any)  { v.attr = slog.Any(name, value) }
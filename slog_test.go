@@ -0,0 +1,68 @@
+package capitan
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestFieldToSlogAttr(t *testing.T) {
+	now := time.Unix(100, 0)
+	testErr := errors.New("boom")
+
+	type custom struct{ ID string }
+
+	tests := []struct {
+		name  string
+		field Field
+		kind  slog.Kind
+		value any
+	}{
+		{"string", NewStringKey("k").Field("v"), slog.KindString, "v"},
+		{"int", NewIntKey("k").Field(1), slog.KindInt64, int64(1)},
+		{"int32", NewInt32Key("k").Field(2), slog.KindInt64, int64(2)},
+		{"int64", NewInt64Key("k").Field(3), slog.KindInt64, int64(3)},
+		{"uint", NewUintKey("k").Field(4), slog.KindUint64, uint64(4)},
+		{"uint32", NewUint32Key("k").Field(5), slog.KindUint64, uint64(5)},
+		{"uint64", NewUint64Key("k").Field(6), slog.KindUint64, uint64(6)},
+		{"float32", NewFloat32Key("k").Field(1.5), slog.KindFloat64, 1.5},
+		{"float64", NewFloat64Key("k").Field(2.5), slog.KindFloat64, 2.5},
+		{"bool", NewBoolKey("k").Field(true), slog.KindBool, true},
+		{"time", NewTimeKey("k").Field(now), slog.KindTime, now},
+		{"duration", NewDurationKey("k").Field(time.Second), slog.KindDuration, time.Second},
+		{"error", NewErrorKey("k").Field(testErr), slog.KindAny, testErr},
+		{"custom", NewKey[custom]("k", "test.custom").Field(custom{ID: "c"}), slog.KindAny, custom{ID: "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attr := FieldToSlogAttr(tt.field)
+			if attr.Key != "k" {
+				t.Errorf("expected key %q, got %q", "k", attr.Key)
+			}
+			if attr.Value.Kind() != tt.kind {
+				t.Errorf("expected kind %v, got %v", tt.kind, attr.Value.Kind())
+			}
+			if attr.Value.Any() != tt.value {
+				t.Errorf("expected value %v, got %v", tt.value, attr.Value.Any())
+			}
+		})
+	}
+}
+
+func TestFieldToSlogAttrBytes(t *testing.T) {
+	attr := FieldToSlogAttr(NewBytesKey("k").Field([]byte("b")))
+	if attr.Value.Kind() != slog.KindAny {
+		t.Errorf("expected KindAny, got %v", attr.Value.Kind())
+	}
+	if b, ok := attr.Value.Any().([]byte); !ok || string(b) != "b" {
+		t.Errorf("expected bytes value, got %v", attr.Value.Any())
+	}
+}
+
+func TestFieldToSlogAttrNil(t *testing.T) {
+	if attr := FieldToSlogAttr(nil); !attr.Equal(slog.Attr{}) {
+		t.Errorf("expected empty attr for nil field, got %v", attr)
+	}
+}
@@ -0,0 +1,292 @@
+package capitan
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// structTag is the struct tag consulted when converting structs to fields.
+const structTag = "capitan"
+
+// structField describes one exported struct field in a cached conversion plan.
+type structField struct {
+	name  string
+	index []int
+}
+
+// structPlans caches the field plan for each struct type.
+var structPlans sync.Map // map[reflect.Type][]structField
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	bytesType    = reflect.TypeOf([]byte(nil))
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// FieldsOf converts the exported fields of a struct (or pointer to struct) into fields.
+//
+// Field names default to the Go field name and can be overridden with a
+// `capitan:"name"` tag; `capitan:"-"` excludes a field. Embedded structs are
+// flattened unless tagged. Pointer fields are dereferenced, and nil pointers
+// produce no field. Built-in types map to their variants; anything else is
+// carried as a VariantAny field. Returns nil if v is not a struct.
+func FieldsOf(v any) []Field {
+	rv, ok := structValue(v)
+	if !ok {
+		return nil
+	}
+
+	plan := structPlan(rv.Type())
+	fields := make([]Field, 0, len(plan))
+	for _, sf := range plan {
+		fv, ok := fieldByIndex(rv, sf.index)
+		if !ok {
+			continue
+		}
+		fields = append(fields, fieldFromReflect(sf.name, fv))
+	}
+	return fields
+}
+
+// EmitStruct emits the exported fields of v (a struct or pointer to struct)
+// as an Info event, naming fields as FieldsOf does. Fields whose type has no
+// built-in variant are skipped, or, when the instance is configured with
+// WithStrictStructs, cause EmitStruct to return ErrUnsupportedField without
+// emitting. Returns ErrNotStruct if v is not a struct.
+func EmitStruct[T any](ctx context.Context, c *Capitan, signal Signal, v T) error {
+	rv, ok := structValue(v)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotStruct, v)
+	}
+
+	plan := structPlan(rv.Type())
+	fields := make([]Field, 0, len(plan))
+	for _, sf := range plan {
+		fv, ok := fieldByIndex(rv, sf.index)
+		if !ok {
+			continue
+		}
+		field := fieldFromReflect(sf.name, fv)
+		if field.Variant() == VariantAny {
+			if c.strictStructs {
+				return fmt.Errorf("%w: %s has type %s", ErrUnsupportedField, sf.name, fv.Type())
+			}
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	c.Emit(ctx, signal, fields...)
+	return nil
+}
+
+// structValue dereferences v to a struct value, reporting false if it isn't one.
+func structValue(v any) (reflect.Value, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+	return rv, rv.Kind() == reflect.Struct
+}
+
+// structPlan returns the cached conversion plan for a struct type.
+func structPlan(t reflect.Type) []structField {
+	if plan, ok := structPlans.Load(t); ok {
+		return plan.([]structField) //nolint:errcheck // Map only stores []structField
+	}
+	plan := buildStructPlan(t, nil, map[reflect.Type]bool{})
+	structPlans.Store(t, plan)
+	return plan
+}
+
+// buildStructPlan walks a struct type, flattening untagged embedded structs.
+// visiting holds the types on the current embedding path: an embedded struct
+// already on it, such as a self-embedding pointer, is skipped.
+func buildStructPlan(t reflect.Type, parent []int, visiting map[reflect.Type]bool) []structField {
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	var plan []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get(structTag)
+		if tag == "-" {
+			continue
+		}
+
+		index := make([]int, len(parent)+1)
+		copy(index, parent)
+		index[len(parent)] = i
+
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if !visiting[ft] {
+					plan = append(plan, buildStructPlan(ft, index, visiting)...)
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = f.Name
+		}
+		plan = append(plan, structField{name: name, index: index})
+	}
+	return plan
+}
+
+// fieldByIndex resolves a nested field, dereferencing pointers.
+// Returns false if a nil pointer is encountered along the path or at the field itself.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 {
+			if v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return reflect.Value{}, false
+				}
+				v = v.Elem()
+			}
+		}
+		v = v.Field(x)
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+// fieldFromReflect builds a field from a reflected value, inferring its variant.
+func fieldFromReflect(name string, v reflect.Value) Field {
+	switch v.Type() {
+	case timeType:
+		return NewTimeKey(name).Field(v.Interface().(time.Time)) //nolint:errcheck // Type checked above
+	case durationType:
+		return NewDurationKey(name).Field(time.Duration(v.Int()))
+	case bytesType:
+		return NewBytesKey(name).Field(v.Bytes())
+	}
+	if v.Type().Implements(errorType) {
+		return NewErrorKey(name).Field(v.Interface().(error)) //nolint:errcheck // Implements checked above
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return NewStringKey(name).Field(v.String())
+	case reflect.Int:
+		return NewIntKey(name).Field(int(v.Int()))
+	case reflect.Int32:
+		return NewInt32Key(name).Field(int32(v.Int())) //nolint:gosec // Kind guarantees range
+	case reflect.Int64:
+		return NewInt64Key(name).Field(v.Int())
+	case reflect.Uint:
+		return NewUintKey(name).Field(uint(v.Uint()))
+	case reflect.Uint32:
+		return NewUint32Key(name).Field(uint32(v.Uint())) //nolint:gosec // Kind guarantees range
+	case reflect.Uint64:
+		return NewUint64Key(name).Field(v.Uint())
+	case reflect.Float32:
+		return NewFloat32Key(name).Field(float32(v.Float()))
+	case reflect.Float64:
+		return NewFloat64Key(name).Field(v.Float())
+	case reflect.Bool:
+		return NewBoolKey(name).Field(v.Bool())
+	default:
+		return NewAnyKey(name).Field(v.Interface())
+	}
+}
+
+// HookStruct registers a callback that receives each event decoded into a T.
+// T must be a struct or pointer to struct. Event fields are matched to struct
+// fields by the same names FieldsOf produces (Go field name or `capitan` tag).
+// Struct fields without a matching event field, or whose event value has an
+// incompatible type, are left zero; extra event fields are ignored.
+// The per-type field plan is cached and shared with FieldsOf.
+func HookStruct[T any](c *Capitan, signal Signal, callback func(context.Context, T)) *Listener {
+	return c.Hook(signal, func(ctx context.Context, e *Event) {
+		var v T
+		decodeStruct(e, reflect.ValueOf(&v).Elem())
+		callback(ctx, v)
+	})
+}
+
+// decodeStruct populates v, a struct or pointer to struct, from the event's fields.
+func decodeStruct(e *Event, v reflect.Value) {
+	if v.Kind() == reflect.Pointer {
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for _, sf := range structPlan(v.Type()) {
+		field, ok := e.fields[sf.name]
+		if !ok {
+			continue
+		}
+		value := reflect.ValueOf(field.Value())
+		if !value.IsValid() {
+			continue
+		}
+		if dst, ok := settableByIndex(v, sf.index); ok {
+			setField(dst, value)
+		}
+	}
+}
+
+// settableByIndex resolves a nested field for assignment, allocating nil
+// embedded struct pointers along the path. Returns false if the path passes
+// through a nil pointer that cannot be allocated (e.g. an unexported embed).
+func settableByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, v.CanSet()
+}
+
+// setField assigns value to dst, allocating through pointer fields.
+// Leaves dst unchanged if the types are incompatible.
+func setField(dst, value reflect.Value) {
+	if value.Type().AssignableTo(dst.Type()) {
+		dst.Set(value)
+		return
+	}
+	if dst.Kind() == reflect.Pointer && value.Type().AssignableTo(dst.Type().Elem()) {
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(value)
+		dst.Set(ptr)
+	}
+}
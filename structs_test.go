@@ -0,0 +1,318 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type structsAudit struct {
+	CreatedBy string
+	Revision  int `capitan:"rev"`
+}
+
+type structsOrder struct {
+	structsAudit
+	ID       string        `capitan:"order_id"`
+	Total    float64       `capitan:"total"`
+	Paid     bool          `capitan:"paid"`
+	Note     *string       `capitan:"note"`
+	Coupon   *string       `capitan:"coupon"`
+	Elapsed  time.Duration `capitan:"elapsed"`
+	Created  time.Time     `capitan:"created"`
+	Raw      []byte        `capitan:"raw"`
+	Err      error         `capitan:"err"`
+	Tags     []string      `capitan:"tags"`
+	Secret   string        `capitan:"-"`
+	internal string
+}
+
+func TestFieldsOf(t *testing.T) {
+	note := "leave at door"
+	created := time.Unix(100, 0)
+	testErr := errors.New("declined")
+
+	order := structsOrder{
+		structsAudit: structsAudit{CreatedBy: "alice", Revision: 2},
+		ID:           "ORDER-1",
+		Total:        9.5,
+		Paid:         true,
+		Note:         &note,
+		Elapsed:      time.Second,
+		Created:      created,
+		Raw:          []byte("r"),
+		Err:          testErr,
+		Tags:         []string{"a"},
+		Secret:       "hidden",
+		internal:     "x",
+	}
+
+	byName := make(map[string]Field)
+	for _, f := range FieldsOf(order) {
+		byName[f.Key().Name()] = f
+	}
+
+	expected := map[string]Variant{
+		"CreatedBy": VariantString,
+		"rev":       VariantInt,
+		"order_id":  VariantString,
+		"total":     VariantFloat64,
+		"paid":      VariantBool,
+		"note":      VariantString,
+		"elapsed":   VariantDuration,
+		"created":   VariantTime,
+		"raw":       VariantBytes,
+		"err":       VariantError,
+		"tags":      VariantAny,
+	}
+
+	if len(byName) != len(expected) {
+		t.Errorf("expected %d fields, got %d: %v", len(expected), len(byName), byName)
+	}
+	for name, variant := range expected {
+		f, ok := byName[name]
+		if !ok {
+			t.Errorf("missing field %q", name)
+			continue
+		}
+		if f.Variant() != variant {
+			t.Errorf("field %q: expected variant %q, got %q", name, variant, f.Variant())
+		}
+	}
+
+	if byName["note"].Value() != note {
+		t.Errorf("expected dereferenced note %q, got %v", note, byName["note"].Value())
+	}
+	for _, excluded := range []string{"coupon", "Secret", "internal", "structsAudit"} {
+		if _, ok := byName[excluded]; ok {
+			t.Errorf("field %q should not be present", excluded)
+		}
+	}
+}
+
+func TestFieldsOfPointerAndNonStruct(t *testing.T) {
+	order := &structsOrder{ID: "ORDER-2"}
+	fields := FieldsOf(order)
+	if len(fields) == 0 {
+		t.Fatal("expected fields from pointer to struct")
+	}
+
+	var nilOrder *structsOrder
+	if fields := FieldsOf(nilOrder); fields != nil {
+		t.Errorf("expected nil for nil pointer, got %v", fields)
+	}
+	if fields := FieldsOf(42); fields != nil {
+		t.Errorf("expected nil for non-struct, got %v", fields)
+	}
+}
+
+func TestFieldsOfNamedScalar(t *testing.T) {
+	type status string
+	type payload struct {
+		Status status
+	}
+
+	fields := FieldsOf(payload{Status: "active"})
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+	if fields[0].Variant() != VariantString || fields[0].Value() != "active" {
+		t.Errorf("expected string variant with value active, got %q %v", fields[0].Variant(), fields[0].Value())
+	}
+}
+
+type structsNode struct {
+	*structsNode
+	Name string
+}
+
+type structsLeft struct {
+	*structsRight
+	Left string
+}
+
+type structsRight struct {
+	*structsLeft
+	Right string
+}
+
+func TestFieldsOfSelfEmbedding(t *testing.T) {
+	node := structsNode{structsNode: &structsNode{Name: "parent"}, Name: "child"}
+	fields := FieldsOf(node)
+	if len(fields) != 1 || fields[0].Key().Name() != "Name" || fields[0].Value() != "child" {
+		t.Errorf("expected only the outer Name field, got %v", fields)
+	}
+
+	left := structsLeft{structsRight: &structsRight{Right: "r"}, Left: "l"}
+	fields = FieldsOf(left)
+	if len(fields) != 2 {
+		t.Errorf("expected Right and Left fields from mutually embedding types, got %v", fields)
+	}
+}
+
+func BenchmarkFieldsOf(b *testing.B) {
+	note := "n"
+	order := structsOrder{
+		structsAudit: structsAudit{CreatedBy: "alice", Revision: 2},
+		ID:           "ORDER-1",
+		Total:        9.5,
+		Note:         &note,
+		Created:      time.Unix(100, 0),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = FieldsOf(order)
+	}
+}
+
+func TestHookStruct(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.hook", "Test HookStruct signal")
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	sentinel := errors.New("declined")
+
+	var got structsOrder
+	HookStruct(c, sig, func(_ context.Context, o structsOrder) { got = o })
+
+	c.Emit(context.Background(), sig,
+		NewStringKey("CreatedBy").Field("alice"),
+		NewIntKey("rev").Field(3),
+		NewStringKey("order_id").Field("ORD-1"),
+		NewFloat64Key("total").Field(99.5),
+		NewStringKey("note").Field("gift"),
+		NewDurationKey("elapsed").Field(time.Second),
+		NewTimeKey("created").Field(created),
+		NewErrorKey("err").Field(sentinel),
+		NewAnyKey("tags").Field([]string{"a", "b"}),
+		NewStringKey("Secret").Field("leaked"),
+		NewStringKey("paid").Field("yes"), // wrong type: left zero
+		NewStringKey("extra").Field("ignored"),
+	)
+
+	if got.CreatedBy != "alice" || got.Revision != 3 {
+		t.Errorf("expected embedded fields populated, got %+v", got.structsAudit)
+	}
+	if got.ID != "ORD-1" || got.Total != 99.5 || got.Elapsed != time.Second || !got.Created.Equal(created) {
+		t.Errorf("unexpected scalar fields: %+v", got)
+	}
+	if got.Note == nil || *got.Note != "gift" || got.Coupon != nil {
+		t.Errorf("expected Note allocated and Coupon nil, got %v/%v", got.Note, got.Coupon)
+	}
+	if !errors.Is(got.Err, sentinel) || len(got.Tags) != 2 {
+		t.Errorf("unexpected interface and any fields: %v %v", got.Err, got.Tags)
+	}
+	if got.Paid || got.Secret != "" || got.Raw != nil {
+		t.Errorf("expected mismatched, excluded, and missing fields zero, got %+v", got)
+	}
+}
+
+func TestHookStructPointer(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.hook.ptr", "Test HookStruct pointer signal")
+
+	var got *structsAudit
+	HookStruct(c, sig, func(_ context.Context, a *structsAudit) { got = a })
+
+	c.Emit(context.Background(), sig, NewStringKey("CreatedBy").Field("bob"))
+
+	if got == nil || got.CreatedBy != "bob" || got.Revision != 0 {
+		t.Errorf("expected allocated struct with CreatedBy, got %+v", got)
+	}
+}
+
+func TestHookStructRoundTrip(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.hook.roundtrip", "Test HookStruct round trip signal")
+	note := "n"
+	in := structsOrder{ID: "ORD-2", Total: 1, Paid: true, Note: &note, Raw: []byte("x")}
+	in.CreatedBy = "carol"
+
+	var out structsOrder
+	HookStruct(c, sig, func(_ context.Context, o structsOrder) { out = o })
+	c.Emit(context.Background(), sig, FieldsOf(in)...)
+
+	if out.ID != in.ID || out.Total != in.Total || !out.Paid || *out.Note != note ||
+		string(out.Raw) != "x" || out.CreatedBy != "carol" {
+		t.Errorf("expected FieldsOf round trip, got %+v", out)
+	}
+}
+
+func TestEmitStruct(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.emit", "Test EmitStruct signal")
+	var got *Event
+	c.Hook(sig, func(_ context.Context, e *Event) { got = e.clone(eventPool) })
+
+	note := "gift"
+	order := structsOrder{ID: "ORD-1", Total: 12.5, Note: &note, Tags: []string{"x"}}
+	order.CreatedBy = "alice"
+
+	if err := EmitStruct(context.Background(), c, sig, &order); err != nil {
+		t.Fatalf("EmitStruct failed: %v", err)
+	}
+	if got == nil {
+		t.Fatal("expected event to be emitted")
+	}
+
+	if id, _ := NewStringKey("order_id").From(got); id != "ORD-1" {
+		t.Errorf("expected order_id ORD-1, got %q", id)
+	}
+	if by, _ := NewStringKey("CreatedBy").From(got); by != "alice" {
+		t.Errorf("expected embedded CreatedBy, got %q", by)
+	}
+	if f := got.Get(NewFloat64Key("total")); f == nil || f.Variant() != VariantFloat64 {
+		t.Errorf("expected float64 total, got %v", f)
+	}
+	if got.Get(NewAnyKey("tags")) != nil {
+		t.Error("expected unsupported []string field to be skipped")
+	}
+	if got.Get(NewStringKey("Secret")) != nil || got.Get(NewStringKey("coupon")) != nil {
+		t.Error("expected excluded and nil fields to be absent")
+	}
+}
+
+func TestEmitStructStrict(t *testing.T) {
+	c := New(WithSyncMode(), WithStrictStructs())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.emit.strict", "Test strict EmitStruct signal")
+	emitted := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { emitted++ })
+
+	err := EmitStruct(context.Background(), c, sig, structsOrder{Tags: []string{"x"}})
+	if !errors.Is(err, ErrUnsupportedField) {
+		t.Errorf("expected ErrUnsupportedField, got %v", err)
+	}
+
+	if err := EmitStruct(context.Background(), c, sig, structsAudit{CreatedBy: "bob"}); err != nil {
+		t.Errorf("expected supported struct to emit, got %v", err)
+	}
+	if emitted != 1 {
+		t.Errorf("expected only the supported struct emitted, got %d", emitted)
+	}
+}
+
+func TestEmitStructNotStruct(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.structs.emit.invalid", "Test invalid EmitStruct signal")
+	if err := EmitStruct(context.Background(), c, sig, 42); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct, got %v", err)
+	}
+	var nilOrder *structsOrder
+	if err := EmitStruct(context.Background(), c, sig, nilOrder); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct for nil pointer, got %v", err)
+	}
+}
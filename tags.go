@@ -0,0 +1,46 @@
+package capitan
+
+import (
+	"slices"
+	"sort"
+)
+
+// TagSignal attaches tags to a signal on the default instance.
+func TagSignal(signal Signal, tags ...string) {
+	defaultInstance().TagSignal(signal, tags...)
+}
+
+// SignalsByTag returns the signals carrying tag on the default instance.
+func SignalsByTag(tag string) []Signal {
+	return defaultInstance().SignalsByTag(tag)
+}
+
+// TagSignal attaches organizational tags (e.g. "billing", "auth") to a signal.
+// Tags are metadata only and don't affect routing. Duplicate tags are ignored.
+func (c *Capitan) TagSignal(signal Signal, tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range tags {
+		if !slices.Contains(c.signalTags[signal], tag) {
+			c.signalTags[signal] = append(c.signalTags[signal], tag)
+		}
+	}
+}
+
+// SignalsByTag returns all signals carrying tag, sorted by name.
+func (c *Capitan) SignalsByTag(tag string) []Signal {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var signals []Signal
+	for signal, tags := range c.signalTags {
+		if slices.Contains(tags, tag) {
+			signals = append(signals, signal)
+		}
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].Name() < signals[j].Name()
+	})
+	return signals
+}
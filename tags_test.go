@@ -0,0 +1,54 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSignalsByTag(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	charge := NewSignal("billing.charge", "Charge created")
+	refund := NewSignal("billing.refund", "Refund issued")
+	login := NewSignal("auth.login", "User logged in")
+
+	c.TagSignal(refund, "billing", "finance")
+	c.TagSignal(charge, "billing", "billing")
+	c.TagSignal(login, "auth")
+
+	billing := c.SignalsByTag("billing")
+	if len(billing) != 2 || billing[0] != charge || billing[1] != refund {
+		t.Errorf("expected [charge refund], got %v", billing)
+	}
+	if got := c.SignalsByTag("auth"); len(got) != 1 || got[0] != login {
+		t.Errorf("expected [login], got %v", got)
+	}
+	if got := c.SignalsByTag("missing"); len(got) != 0 {
+		t.Errorf("expected no signals, got %v", got)
+	}
+
+	tags := c.Stats().SignalTags
+	if len(tags[charge]) != 1 || len(tags[refund]) != 2 {
+		t.Errorf("expected deduplicated tags in stats, got %v", tags)
+	}
+}
+
+func TestTagSignalDoesNotAffectRouting(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.tags.routing", "Test tags routing signal")
+	received := 0
+	c.Hook(sig, func(_ context.Context, _ *Event) { received++ })
+
+	c.TagSignal(sig, "ops")
+	c.Emit(context.Background(), sig)
+
+	if received != 1 {
+		t.Errorf("expected 1 event, got %d", received)
+	}
+	if c.Stats().ListenerCounts[sig] != 1 {
+		t.Error("tagging should not register listeners")
+	}
+}
@@ -0,0 +1,51 @@
+package capitan
+
+// ThresholdFunc is called when a signal's cumulative emit count reaches a
+// threshold registered with OnEmitThreshold.
+type ThresholdFunc func(signal Signal, count uint64)
+
+// emitThreshold is a registered OnEmitThreshold callback.
+type emitThreshold struct {
+	count uint64
+	fn    ThresholdFunc
+}
+
+// OnEmitThreshold registers fn to run when signal's emit count reaches count
+// on the default instance.
+func OnEmitThreshold(signal Signal, count uint64, fn ThresholdFunc) {
+	defaultInstance().OnEmitThreshold(signal, count, fn)
+}
+
+// OnEmitThreshold registers fn to run once, on the emitting goroutine, when
+// signal's cumulative emit count (as reported by Stats.EmitCounts) reaches
+// count. Useful for simple milestones such as "first 1000 signups" without an
+// external alerting system. Thresholds the signal has already passed never
+// fire. fn should return quickly, as it runs inline with the emit.
+func (c *Capitan) OnEmitThreshold(signal Signal, count uint64, fn ThresholdFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.emitThresholds == nil {
+		c.emitThresholds = make(map[Signal][]emitThreshold)
+	}
+	c.emitThresholds[signal] = append(c.emitThresholds[signal], emitThreshold{count: count, fn: fn})
+	c.hasThresholds.Store(true)
+}
+
+// checkThresholds runs the callbacks whose threshold equals total. Emit counts
+// grow by one under the metrics lock, so each total is seen by exactly one
+// emit and every threshold fires at most once.
+func (c *Capitan) checkThresholds(signal Signal, total uint64) {
+	c.mu.RLock()
+	var fire []ThresholdFunc
+	for _, t := range c.emitThresholds[signal] {
+		if t.count == total {
+			fire = append(fire, t.fn)
+		}
+	}
+	c.mu.RUnlock()
+
+	for _, fn := range fire {
+		fn(signal, total)
+	}
+}
@@ -0,0 +1,75 @@
+package capitan
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestOnEmitThreshold verifies each threshold fires exactly once when crossed.
+func TestOnEmitThreshold(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.threshold", "Test threshold signal")
+	other := NewSignal("test.threshold.other", "Test threshold other signal")
+
+	var mu sync.Mutex
+	fired := map[uint64]int{}
+	record := func(s Signal, count uint64) {
+		if s != sig {
+			t.Errorf("expected signal %s, got %s", sig.Name(), s.Name())
+		}
+		mu.Lock()
+		fired[count]++
+		mu.Unlock()
+	}
+	c.OnEmitThreshold(sig, 10, record)
+	c.OnEmitThreshold(sig, 100, record)
+	c.OnEmitThreshold(sig, 1000, record)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				c.Emit(context.Background(), sig)
+				c.Emit(context.Background(), other)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if fired[10] != 1 || fired[100] != 1 {
+		t.Errorf("expected thresholds 10 and 100 to fire once, got %v", fired)
+	}
+	if fired[1000] != 0 {
+		t.Errorf("expected threshold 1000 not reached, got %v", fired)
+	}
+}
+
+// TestOnEmitThresholdAlreadyPassed verifies thresholds behind the current count never fire.
+func TestOnEmitThresholdAlreadyPassed(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.threshold.passed", "Test threshold passed signal")
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig)
+	}
+
+	var calls atomic.Int32
+	c.OnEmitThreshold(sig, 3, func(Signal, uint64) { calls.Add(1) })
+	c.OnEmitThreshold(sig, 6, func(Signal, uint64) { calls.Add(1) })
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected only the upcoming threshold to fire, got %d", calls.Load())
+	}
+}
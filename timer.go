@@ -0,0 +1,46 @@
+package capitan
+
+import (
+	"context"
+	"time"
+)
+
+// TimeFuncErrorKey is the field TimeFunc adds when the timed function fails.
+var TimeFuncErrorKey = NewErrorKey("error")
+
+// Timer measures elapsed time for a DurationKey field.
+type Timer struct {
+	key   DurationKey
+	start time.Time
+}
+
+// StartTimer starts measuring time for key:
+//
+//	t := capitan.StartTimer(latencyKey)
+//	// ...
+//	c.Emit(ctx, sig, t.Field())
+func StartTimer(key DurationKey) Timer {
+	return Timer{key: key, start: time.Now()}
+}
+
+// Field returns a field holding the time elapsed since StartTimer.
+// Each call measures anew, so a Timer can report several checkpoints.
+func (t Timer) Field() Field {
+	return t.key.Field(time.Since(t.start))
+}
+
+// TimeFunc runs fn and emits its duration under key on signal. If fn fails,
+// the event is emitted with Error severity and a TimeFuncErrorKey field;
+// otherwise with Info severity. The duration is measured with c's clock, so
+// WithClock and WithTimeSource apply. Returns fn's error.
+func TimeFunc(ctx context.Context, c *Capitan, signal Signal, key DurationKey, fn func() error) error {
+	start := c.clock()
+	err := fn()
+	elapsed := key.Field(c.clock().Sub(start))
+	if err != nil {
+		c.Error(ctx, signal, elapsed, TimeFuncErrorKey.Field(err))
+		return err
+	}
+	c.Info(ctx, signal, elapsed)
+	return nil
+}
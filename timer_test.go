@@ -0,0 +1,84 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStartTimer(t *testing.T) {
+	latency := NewDurationKey("latency")
+	timer := StartTimer(latency)
+	time.Sleep(20 * time.Millisecond)
+
+	f := timer.Field()
+	elapsed, ok := f.Value().(time.Duration)
+	if !ok || f.Key().Name() != "latency" {
+		t.Fatalf("expected latency duration field, got %v", f)
+	}
+	if elapsed < 20*time.Millisecond || elapsed > time.Second {
+		t.Errorf("expected about 20ms, got %v", elapsed)
+	}
+}
+
+func TestTimeFunc(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.timefunc", "Test time func signal")
+	took := NewDurationKey("took")
+
+	type result struct {
+		severity Severity
+		elapsed  time.Duration
+		err      error
+	}
+	var got []result
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		elapsed, _ := took.From(e)
+		err, _ := TimeFuncErrorKey.From(e)
+		got = append(got, result{e.Severity(), elapsed, err})
+	})
+
+	errBoom := errors.New("boom")
+	if err := TimeFunc(context.Background(), c, sig, took, func() error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := TimeFunc(context.Background(), c, sig, took, func() error { return errBoom }); err != errBoom {
+		t.Fatalf("expected fn error returned, got %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+	if got[0].severity != SeverityInfo || got[0].err != nil || got[0].elapsed < 20*time.Millisecond || got[0].elapsed > time.Second {
+		t.Errorf("unexpected success event: %+v", got[0])
+	}
+	if got[1].severity != SeverityError || got[1].err != errBoom {
+		t.Errorf("unexpected failure event: %+v", got[1])
+	}
+}
+
+func TestTimeFuncUsesClock(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	c := New(WithSyncMode(), WithClock(func() time.Time { return now }))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.timefunc.clock", "Test time func clock signal")
+	took := NewDurationKey("took")
+
+	var elapsed time.Duration
+	c.Hook(sig, func(_ context.Context, e *Event) { elapsed, _ = took.From(e) })
+
+	_ = TimeFunc(context.Background(), c, sig, took, func() error {
+		now = now.Add(3 * time.Second)
+		return nil
+	})
+	if elapsed != 3*time.Second {
+		t.Errorf("expected clock-measured 3s, got %v", elapsed)
+	}
+}
@@ -0,0 +1,44 @@
+package capitan
+
+import "context"
+
+// payloadKeyName is the reserved field name carrying a Topic's payload.
+const payloadKeyName = "capitan.payload"
+
+// PayloadKey reads a Topic payload from any event without knowing its type.
+// Observers and plain listeners can use it to access payloads emitted via EmitT.
+var PayloadKey = NewAnyKey(payloadKeyName)
+
+// Topic binds a Signal to a single payload type T.
+// Events emitted with EmitT carry the payload as one field under a reserved key,
+// and HookT delivers it already typed, so callbacks never extract fields by hand.
+type Topic[T any] struct {
+	Signal Signal
+}
+
+// NewTopic creates a Topic with a new Signal of the given name and description.
+func NewTopic[T any](name, description string) Topic[T] {
+	return Topic[T]{Signal: NewSignal(name, description)}
+}
+
+// key returns the typed key carrying this topic's payload.
+func (Topic[T]) key() GenericKey[T] {
+	return GenericKey[T]{name: payloadKeyName, variant: VariantAny}
+}
+
+// EmitT dispatches payload on the topic's signal with Info severity.
+func EmitT[T any](ctx context.Context, c *Capitan, t Topic[T], payload T) {
+	c.Emit(ctx, t.Signal, t.key().Field(payload))
+}
+
+// HookT registers a typed callback for the topic's signal.
+// Events on the signal that don't carry a T payload are skipped.
+// Returns a Listener that can be closed to unregister.
+func HookT[T any](c *Capitan, t Topic[T], callback func(context.Context, T)) *Listener {
+	key := t.key()
+	return c.Hook(t.Signal, func(ctx context.Context, e *Event) {
+		if payload, ok := key.From(e); ok {
+			callback(ctx, payload)
+		}
+	})
+}
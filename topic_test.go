@@ -0,0 +1,107 @@
+package capitan
+
+import (
+	"context"
+	"testing"
+)
+
+type topicOrder struct {
+	ID    string
+	Total float64
+}
+
+func TestTopicEmitHook(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	topic := NewTopic[topicOrder]("test.topic.order", "Test topic signal")
+
+	var received topicOrder
+	HookT(c, topic, func(_ context.Context, o topicOrder) {
+		received = o
+	})
+
+	EmitT(context.Background(), c, topic, topicOrder{ID: "ORDER-1", Total: 42.5})
+
+	if received.ID != "ORDER-1" || received.Total != 42.5 {
+		t.Errorf("unexpected payload: %+v", received)
+	}
+}
+
+func TestTopicObserverInterop(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	topic := NewTopic[topicOrder]("test.topic.observer", "Test topic observer signal")
+
+	var typed, observed topicOrder
+	var observedOK bool
+	var fieldCount int
+
+	c.Observe(func(_ context.Context, e *Event) {
+		var payload any
+		payload, observedOK = PayloadKey.From(e)
+		observed, _ = payload.(topicOrder)
+		fieldCount = len(e.Fields())
+	})
+	HookT(c, topic, func(_ context.Context, o topicOrder) {
+		typed = o
+	})
+
+	EmitT(context.Background(), c, topic, topicOrder{ID: "ORDER-2", Total: 10})
+
+	if typed.ID != "ORDER-2" {
+		t.Errorf("typed hook: unexpected payload %+v", typed)
+	}
+	if !observedOK || observed.ID != "ORDER-2" {
+		t.Errorf("observer: expected payload via PayloadKey, got %+v (ok=%v)", observed, observedOK)
+	}
+	if fieldCount != 1 {
+		t.Errorf("observer: expected 1 field, got %d", fieldCount)
+	}
+}
+
+func TestTopicSkipsEventsWithoutPayload(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	topic := NewTopic[topicOrder]("test.topic.skip", "Test topic skip signal")
+
+	calls := 0
+	HookT(c, topic, func(_ context.Context, _ topicOrder) {
+		calls++
+	})
+
+	// Plain emit on the same signal without a payload
+	c.Emit(context.Background(), topic.Signal, NewStringKey("other").Field("x"))
+	// Payload of the wrong type under the reserved name
+	c.Emit(context.Background(), topic.Signal, NewStringKey(payloadKeyName).Field("wrong"))
+
+	if calls != 0 {
+		t.Errorf("expected no typed callbacks, got %d", calls)
+	}
+}
+
+func TestAnyKeyFrom(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.anykey", "Test any key signal")
+	intKey := NewIntKey("count")
+	anyKey := NewAnyKey("count")
+
+	var value any
+	var ok bool
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		value, ok = anyKey.From(e)
+	})
+
+	c.Emit(context.Background(), sig, intKey.Field(7))
+
+	if !ok || value != 7 {
+		t.Errorf("expected 7 (ok=true), got %v (ok=%v)", value, ok)
+	}
+	if anyKey.Variant() != VariantAny {
+		t.Errorf("expected variant %q, got %q", VariantAny, anyKey.Variant())
+	}
+}
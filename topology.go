@@ -0,0 +1,174 @@
+package capitan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Topology is a point-in-time snapshot of an instance's wiring, for debugging.
+type Topology struct {
+	SyncMode   bool               `json:"sync_mode"`
+	BufferSize int                `json:"buffer_size"`
+	Signals    []SignalTopology   `json:"signals"`
+	Observers  []ObserverTopology `json:"observers"`
+}
+
+// SignalTopology describes a registered signal and its worker.
+type SignalTopology struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags,omitempty"`
+
+	// Listeners names each hooked listener (falling back to its function name).
+	// Observer attachments are counted separately.
+	Listeners         []string `json:"listeners"`
+	ObserverListeners int      `json:"observer_listeners"`
+
+	WorkerActive  bool `json:"worker_active"`
+	QueueDepth    int  `json:"queue_depth"`
+	QueueCapacity int  `json:"queue_capacity"`
+}
+
+// ObserverTopology describes an active observer.
+type ObserverTopology struct {
+	Kind            ObserverKind `json:"kind"`
+	Whitelist       []string     `json:"whitelist,omitempty"`
+	AttachedSignals int          `json:"attached_signals"`
+}
+
+// Dump returns a coherent snapshot of signals, listeners, observers, and
+// worker queues. Signals and whitelists are sorted by name.
+func (c *Capitan) Dump() Topology {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	topo := Topology{
+		SyncMode:   c.syncMode,
+		BufferSize: c.bufferSize,
+		Signals:    make([]SignalTopology, 0, len(c.registry)),
+		Observers:  make([]ObserverTopology, 0, len(c.observers)),
+	}
+
+	for signal, listeners := range c.registry {
+		st := SignalTopology{
+			Name:        signal.Name(),
+			Description: signal.Description(),
+			Tags:        append([]string(nil), c.signalTags[signal]...),
+			Listeners:   []string{},
+		}
+		for _, l := range listeners {
+			if l.observer != nil {
+				st.ObserverListeners++
+				continue
+			}
+			st.Listeners = append(st.Listeners, l.label())
+		}
+		if worker, ok := c.workers[signal]; ok {
+			st.WorkerActive = true
+			st.QueueDepth = len(worker.events)
+			st.QueueCapacity = cap(worker.events)
+		}
+		topo.Signals = append(topo.Signals, st)
+	}
+	sort.Slice(topo.Signals, func(i, j int) bool {
+		return topo.Signals[i].Name < topo.Signals[j].Name
+	})
+
+	// Lock order: c.mu before obs.mu
+	for _, obs := range c.observers {
+		obs.mu.Lock()
+		ot := ObserverTopology{Kind: ObserverAll, AttachedSignals: len(obs.listeners)}
+		if obs.signals != nil {
+			ot.Kind = ObserverWhitelist
+			for signal := range obs.signals {
+				ot.Whitelist = append(ot.Whitelist, signal.Name())
+			}
+			sort.Strings(ot.Whitelist)
+		}
+		obs.mu.Unlock()
+		topo.Observers = append(topo.Observers, ot)
+	}
+
+	return topo
+}
+
+// DumpJSON writes the instance's Topology to w as indented JSON.
+func (c *Capitan) DumpJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.Dump())
+}
+
+// String renders the topology as readable multi-line text.
+func (t Topology) String() string {
+	var b strings.Builder
+
+	mode := "async"
+	if t.SyncMode {
+		mode = "sync"
+	}
+	fmt.Fprintf(&b, "capitan (%s, buffer %d): %d signals, %d observers\n",
+		mode, t.BufferSize, len(t.Signals), len(t.Observers))
+
+	for _, s := range t.Signals {
+		fmt.Fprintf(&b, "signal %s", s.Name)
+		if s.Description != "" {
+			fmt.Fprintf(&b, " - %s", s.Description)
+		}
+		b.WriteByte('\n')
+		if len(s.Tags) > 0 {
+			fmt.Fprintf(&b, "  tags: %s\n", strings.Join(s.Tags, ", "))
+		}
+		fmt.Fprintf(&b, "  listeners: %d (+%d observer)\n", len(s.Listeners), s.ObserverListeners)
+		for _, name := range s.Listeners {
+			fmt.Fprintf(&b, "    - %s\n", name)
+		}
+		if s.WorkerActive {
+			fmt.Fprintf(&b, "  worker: active, queue %d/%d\n", s.QueueDepth, s.QueueCapacity)
+		} else {
+			b.WriteString("  worker: idle\n")
+		}
+	}
+
+	for i, o := range t.Observers {
+		fmt.Fprintf(&b, "observer #%d (%s): attached to %d signals", i+1, o.Kind, o.AttachedSignals)
+		if len(o.Whitelist) > 0 {
+			fmt.Fprintf(&b, ", whitelist: %s", strings.Join(o.Whitelist, ", "))
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// SignalDescription returns the description of sig. Descriptions travel with
+// the Signal value created by NewSignal; if sig has none, the description of
+// a signal with the same name that the instance has seen (through a listener,
+// an emit, or a tag) is used instead. ok is false if no description is known.
+func (c *Capitan) SignalDescription(sig Signal) (description string, ok bool) {
+	if sig.description != "" {
+		return sig.description, true
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for signal := range c.registry {
+		if signal.name == sig.name && signal.description != "" {
+			return signal.description, true
+		}
+	}
+	for signal := range c.fieldSchemas {
+		if signal.name == sig.name && signal.description != "" {
+			return signal.description, true
+		}
+	}
+	for signal := range c.signalTags {
+		if signal.name == sig.name && signal.description != "" {
+			return signal.description, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,113 @@
+package capitan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func topologyTestListener(_ context.Context, _ *Event) {}
+
+func TestDump(t *testing.T) {
+	c := New(WithBufferSize(4))
+	defer c.Shutdown()
+
+	orders := NewSignal("test.topology.orders", "Order events")
+	users := NewSignal("test.topology.users", "User events")
+
+	c.Hook(orders, topologyTestListener)
+	c.Hook(orders, func(_ context.Context, _ *Event) {})
+	c.Hook(users, topologyTestListener)
+	c.Observe(func(_ context.Context, _ *Event) {}, users)
+	c.TagSignal(orders, "billing")
+
+	c.Emit(context.Background(), orders)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	topo := c.Dump()
+	if topo.BufferSize != 4 || topo.SyncMode {
+		t.Errorf("unexpected config: %+v", topo)
+	}
+	if len(topo.Signals) != 2 || topo.Signals[0].Name != orders.Name() || topo.Signals[1].Name != users.Name() {
+		t.Fatalf("expected sorted orders and users signals, got %+v", topo.Signals)
+	}
+
+	o, u := topo.Signals[0], topo.Signals[1]
+	if len(o.Listeners) != 2 || o.ObserverListeners != 0 || !o.WorkerActive || o.QueueCapacity != 4 {
+		t.Errorf("unexpected orders topology: %+v", o)
+	}
+	if len(u.Listeners) != 1 || u.ObserverListeners != 1 || u.WorkerActive {
+		t.Errorf("unexpected users topology: %+v", u)
+	}
+	if len(topo.Observers) != 1 || topo.Observers[0].Kind != ObserverWhitelist ||
+		len(topo.Observers[0].Whitelist) != 1 || topo.Observers[0].AttachedSignals != 1 {
+		t.Errorf("unexpected observers: %+v", topo.Observers)
+	}
+
+	text := topo.String()
+	for _, want := range []string{
+		"2 signals, 1 observers",
+		"signal test.topology.orders - Order events",
+		"tags: billing",
+		"listeners: 2 (+0 observer)",
+		"topologyTestListener",
+		"worker: active, queue 0/4",
+		"signal test.topology.users - User events",
+		"listeners: 1 (+1 observer)",
+		"worker: idle",
+		"observer #1 (whitelist): attached to 1 signals, whitelist: test.topology.users",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in dump:\n%s", want, text)
+		}
+	}
+}
+
+func TestDumpJSON(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.topology.json", "JSON signal")
+	c.Hook(sig, topologyTestListener)
+
+	var buf bytes.Buffer
+	if err := c.DumpJSON(&buf); err != nil {
+		t.Fatalf("DumpJSON failed: %v", err)
+	}
+
+	var topo Topology
+	if err := json.Unmarshal(buf.Bytes(), &topo); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if !topo.SyncMode || len(topo.Signals) != 1 || topo.Signals[0].Description != "JSON signal" {
+		t.Errorf("unexpected decoded topology: %+v", topo)
+	}
+}
+
+func TestSignalDescription(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	described := NewSignal("test.description", "Order was created")
+	if desc, ok := c.SignalDescription(described); !ok || desc != "Order was created" {
+		t.Errorf("expected own description, got %q %v", desc, ok)
+	}
+
+	bare := NewSignal("test.description", "")
+	if _, ok := c.SignalDescription(bare); ok {
+		t.Error("expected no description before the instance has seen the signal")
+	}
+
+	c.Hook(described, func(_ context.Context, _ *Event) {})
+	if desc, ok := c.SignalDescription(bare); !ok || desc != "Order was created" {
+		t.Errorf("expected description of the known signal with the same name, got %q %v", desc, ok)
+	}
+
+	if _, ok := c.SignalDescription(NewSignal("test.description.unknown", "")); ok {
+		t.Error("expected no description for an unknown signal")
+	}
+}
@@ -23,6 +23,8 @@
 // See https://github.com/zoobzio/capitan for full documentation.
 package capitan
 
+import "sync/atomic"
+
 // Signal represents an event type identifier used for routing events to listeners.
 type Signal struct {
 	name        string
@@ -86,6 +88,7 @@ const (
 	VariantDuration Variant = "time.Duration"
 	VariantBytes    Variant = "[]byte"
 	VariantError    Variant = "error"
+	VariantAny      Variant = "any"
 )
 
 // Field represents a typed value with semantic meaning in an Event.
@@ -121,10 +124,49 @@ func (f GenericField[T]) Value() any { return f.value }
 // Get returns the typed value.
 func (f GenericField[T]) Get() T { return f.value }
 
+// LogValue returns the value rendered in logs and serialized output: the
+// result of the value's LogValue method when T implements LogValuer, and the
+// value itself otherwise.
+func (f GenericField[T]) LogValue() any {
+	if lv, ok := any(f.value).(LogValuer); ok {
+		return lv.LogValue()
+	}
+	return f.value
+}
+
+// LogValuer is implemented by custom field values that control how they are
+// rendered, mirroring slog.LogValuer. Event.String, Event.MarshalJSON, and the
+// slog integration render LogValue() in place of the value, so a type can
+// redact secrets or flatten itself into something loggable. Listeners reading
+// the field with Get or From still see the value itself.
+type LogValuer interface {
+	LogValue() any
+}
+
 // workerState manages the lifecycle of a signal's worker goroutine.
 type workerState struct {
-	events chan *Event   // buffered channel for queuing events
-	done   chan struct{} // signals worker to drain and exit
+	events    chan *Event   // buffered channel for queuing events
+	done      chan struct{} // signals worker to drain and exit
+	exited    chan struct{} // closed once the worker goroutine has returned
+	fullSince atomic.Int64  // unix nanos when the queue was found full; 0 = not full
+	current   atomic.Int64  // timestamp (unix nanos) of the event being processed; 0 = idle
+	highWater atomic.Int64  // maximum observed queue depth
+	enqueued  atomic.Int64  // events successfully queued
+	processed atomic.Int64  // events taken from the queue and fully processed
+
+	// depths samples the queue depth on each dequeue; nil unless WithQueueDepthTracking.
+	depths *depthHistogram
+}
+
+// observeDepth raises the high watermark to the current queue depth if greater.
+func (w *workerState) observeDepth() {
+	depth := int64(len(w.events))
+	for {
+		high := w.highWater.Load()
+		if depth <= high || w.highWater.CompareAndSwap(high, depth) {
+			return
+		}
+	}
 }
 
 // Stats provides runtime metrics for a Capitan instance.
@@ -132,16 +174,139 @@ type Stats struct {
 	// ActiveWorkers is the number of worker goroutines currently running.
 	ActiveWorkers int
 
+	// InFlight is the number of events queued or being processed across all workers.
+	InFlight int
+
 	// QueueDepths maps each signal to the number of events queued in its buffer.
 	QueueDepths map[Signal]int
 
+	// QueueCapacities is the buffer size of each signal's queue.
+	QueueCapacities map[Signal]int
+
+	// QueueHighWatermarks is the maximum queue depth observed since each worker was created.
+	QueueHighWatermarks map[Signal]int
+
+	// MaxQueueDepth is the largest queue depth each worker has seen when
+	// taking an event off its queue. Only populated when configured with
+	// WithQueueDepthTracking.
+	MaxQueueDepth map[Signal]int
+
+	// P95QueueDepth estimates the 95th percentile of each worker's queue depth
+	// when taking an event off its queue, rounded up to one less than a power
+	// of two. Only populated when configured with WithQueueDepthTracking.
+	P95QueueDepth map[Signal]int
+
 	// ListenerCounts maps each signal to the number of registered listeners.
 	ListenerCounts map[Signal]int
 
+	// ListenerNames maps each signal to the names of its listeners registered
+	// with HookNamed, in delivery order. Unnamed listeners are omitted,
+	// and signals without named listeners have no entry.
+	ListenerNames map[Signal][]string
+
 	// EmitCounts maps each signal to the total number of times it has been emitted.
 	EmitCounts map[Signal]uint64
 
+	// ProcessedCounts maps each signal to the number of events all of its
+	// listeners handled without an error or panic. Events that were dropped,
+	// skipped, or that failed a listener are counted in EmitCounts but not
+	// here; a failed event retried successfully is counted once it succeeds.
+	// HookBuffered listeners finish later and don't affect the count.
+	ProcessedCounts map[Signal]uint64
+
+	// SeverityCounts maps each severity to the number of emits, across all signals.
+	SeverityCounts map[Severity]uint64
+
+	// SignalSeverityCounts breaks emit counts down by signal and severity.
+	// Only populated when configured with WithDetailedStats.
+	SignalSeverityCounts map[Signal]map[Severity]uint64
+
+	// SignalTags maps each tagged signal to its tags, as set by TagSignal.
+	SignalTags map[Signal][]string
+
+	// CanceledCounts maps each signal to the number of queued events skipped
+	// because their context was canceled before processing.
+	CanceledCounts map[Signal]uint64
+
+	// DropCounts maps each drop reason to the number of events discarded for it.
+	DropCounts map[DropReason]uint64
+
+	// ObserverCount is the number of active observers, excluding serial observers.
+	ObserverCount int
+
+	// Observers describes each active observer.
+	Observers []ObserverStats
+
 	// FieldSchemas maps each signal to the keys of fields from its first emission.
 	// This provides a schema of what fields are available on each signal.
 	FieldSchemas map[Signal][]Key
 }
+
+// ObserverKind describes how an observer selects signals.
+type ObserverKind string
+
+const (
+	// ObserverAll observes every signal, existing and future.
+	ObserverAll ObserverKind = "all"
+
+	// ObserverWhitelist observes only an explicit set of signals.
+	ObserverWhitelist ObserverKind = "whitelist"
+)
+
+// ObserverStats describes an active observer.
+type ObserverStats struct {
+	// Kind is how the observer selects signals.
+	Kind ObserverKind
+
+	// AttachedSignals is the number of signals the observer is currently attached to.
+	AttachedSignals int
+}
+
+// DropReason describes why an event was discarded before reaching its listeners.
+type DropReason string
+
+const (
+	// DropReasonCanceled means the event's context was canceled before it could be queued or processed.
+	DropReasonCanceled DropReason = "canceled"
+
+	// DropReasonShutdown means the worker or instance shut down while the event was waiting to be queued.
+	DropReasonShutdown DropReason = "shutdown"
+
+	// DropReasonTimeout means the emit timeout elapsed while waiting for queue space.
+	DropReasonTimeout DropReason = "timeout"
+
+	// DropReasonLimit means the event exceeded a field count or size limit at emit time.
+	DropReasonLimit DropReason = "limit"
+
+	// DropReasonSampled means the event was discarded by WithSampling.
+	DropReasonSampled DropReason = "sampled"
+
+	// DropReasonDuplicate means the event had duplicate field names under DupError.
+	DropReasonDuplicate DropReason = "duplicate"
+
+	// DropReasonRejected means a pre-emit hook returned an error.
+	DropReasonRejected DropReason = "rejected"
+
+	// DropReasonIntercepted means an emit interceptor vetoed the event.
+	DropReasonIntercepted DropReason = "intercepted"
+
+	// DropReasonLoop means the emit exceeded the WithMaxEmitDepth nesting limit.
+	DropReasonLoop DropReason = "loop"
+
+	// DropReasonExpired means a Join event found no partner within its window,
+	// or was superseded by a newer event with the same correlation value.
+	DropReasonExpired DropReason = "expired"
+
+	// DropReasonOverflow means a bounded per-listener queue was full, so the
+	// event was dropped for that listener only. HookBuffered, the async
+	// HookDefault fallback, ObserveChan, Events, and PublishTo queues report it.
+	DropReasonOverflow DropReason = "overflow"
+
+	// DropReasonPanic marks a dead letter whose listener panicked.
+	// Panics don't drop the event for other listeners and aren't counted in DropCounts.
+	DropReasonPanic DropReason = "panic"
+
+	// DropReasonListenerError marks a dead letter whose HookE listener returned
+	// an error that was not retried. Not counted in DropCounts.
+	DropReasonListenerError DropReason = "listener_error"
+)
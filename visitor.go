@@ -0,0 +1,125 @@
+package capitan
+
+import "time"
+
+// FieldVisitor receives each field of an event with its typed value.
+// Walk dispatches on Field.Variant() to the matching method; fields with
+// custom variants, or whose concrete type doesn't match their variant,
+// are passed to Default.
+type FieldVisitor interface {
+	String(name string, value string)
+	Int(name string, value int)
+	Int32(name string, value int32)
+	Int64(name string, value int64)
+	Uint(name string, value uint)
+	Uint32(name string, value uint32)
+	Uint64(name string, value uint64)
+	Float32(name string, value float32)
+	Float64(name string, value float64)
+	Bool(name string, value bool)
+	Time(name string, value time.Time)
+	Duration(name string, value time.Duration)
+	Bytes(name string, value []byte)
+	Error(name string, value error)
+
+	// Default handles fields with custom variants.
+	Default(name string, value any)
+}
+
+// Walk visits every field on the event, dispatching by variant.
+// Visit order is unspecified.
+func (e *Event) Walk(visitor FieldVisitor) {
+	for name, field := range e.fields {
+		visitField(visitor, name, field)
+	}
+}
+
+// VisitField dispatches a single field to the visitor method for its
+// variant, as Walk does for each field of an event. Nil fields are skipped.
+// Adapters converting one field at a time use it to share Walk's rules.
+func VisitField(visitor FieldVisitor, f Field) {
+	if f == nil || f.Key() == nil {
+		return
+	}
+	visitField(visitor, f.Key().Name(), f)
+}
+
+// visitField dispatches a single field to the visitor method for its variant.
+func visitField(v FieldVisitor, name string, field Field) {
+	if r, ok := field.(resolver); ok {
+		field = r.resolve()
+	}
+	switch field.Variant() {
+	case VariantString:
+		if f, ok := field.(GenericField[string]); ok {
+			v.String(name, f.Get())
+			return
+		}
+	case VariantInt:
+		if f, ok := field.(GenericField[int]); ok {
+			v.Int(name, f.Get())
+			return
+		}
+	case VariantInt32:
+		if f, ok := field.(GenericField[int32]); ok {
+			v.Int32(name, f.Get())
+			return
+		}
+	case VariantInt64:
+		if f, ok := field.(GenericField[int64]); ok {
+			v.Int64(name, f.Get())
+			return
+		}
+	case VariantUint:
+		if f, ok := field.(GenericField[uint]); ok {
+			v.Uint(name, f.Get())
+			return
+		}
+	case VariantUint32:
+		if f, ok := field.(GenericField[uint32]); ok {
+			v.Uint32(name, f.Get())
+			return
+		}
+	case VariantUint64:
+		if f, ok := field.(GenericField[uint64]); ok {
+			v.Uint64(name, f.Get())
+			return
+		}
+	case VariantFloat32:
+		if f, ok := field.(GenericField[float32]); ok {
+			v.Float32(name, f.Get())
+			return
+		}
+	case VariantFloat64:
+		if f, ok := field.(GenericField[float64]); ok {
+			v.Float64(name, f.Get())
+			return
+		}
+	case VariantBool:
+		if f, ok := field.(GenericField[bool]); ok {
+			v.Bool(name, f.Get())
+			return
+		}
+	case VariantTime:
+		if f, ok := field.(GenericField[time.Time]); ok {
+			v.Time(name, f.Get())
+			return
+		}
+	case VariantDuration:
+		if f, ok := field.(GenericField[time.Duration]); ok {
+			v.Duration(name, f.Get())
+			return
+		}
+	case VariantBytes:
+		if f, ok := field.(GenericField[[]byte]); ok {
+			v.Bytes(name, f.Get())
+			return
+		}
+	case VariantError:
+		if f, ok := field.(GenericField[error]); ok {
+			v.Error(name, f.Get())
+			return
+		}
+	}
+	v.Default(name, field.Value())
+}
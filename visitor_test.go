@@ -0,0 +1,130 @@
+package capitan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// recordingVisitor records which method handled each field.
+type recordingVisitor struct {
+	calls map[string]string
+	vals  map[string]any
+}
+
+func newRecordingVisitor() *recordingVisitor {
+	return &recordingVisitor{calls: make(map[string]string), vals: make(map[string]any)}
+}
+
+func (r *recordingVisitor) record(method, name string, value any) {
+	r.calls[name] = method
+	r.vals[name] = value
+}
+
+func (r *recordingVisitor) String(name string, v string)          { r.record("String", name, v) }
+func (r *recordingVisitor) Int(name string, v int)                { r.record("Int", name, v) }
+func (r *recordingVisitor) Int32(name string, v int32)            { r.record("Int32", name, v) }
+func (r *recordingVisitor) Int64(name string, v int64)            { r.record("Int64", name, v) }
+func (r *recordingVisitor) Uint(name string, v uint)              { r.record("Uint", name, v) }
+func (r *recordingVisitor) Uint32(name string, v uint32)          { r.record("Uint32", name, v) }
+func (r *recordingVisitor) Uint64(name string, v uint64)          { r.record("Uint64", name, v) }
+func (r *recordingVisitor) Float32(name string, v float32)        { r.record("Float32", name, v) }
+func (r *recordingVisitor) Float64(name string, v float64)        { r.record("Float64", name, v) }
+func (r *recordingVisitor) Bool(name string, v bool)              { r.record("Bool", name, v) }
+func (r *recordingVisitor) Time(name string, v time.Time)         { r.record("Time", name, v) }
+func (r *recordingVisitor) Duration(name string, v time.Duration) { r.record("Duration", name, v) }
+func (r *recordingVisitor) Bytes(name string, v []byte)           { r.record("Bytes", name, v) }
+func (r *recordingVisitor) Error(name string, v error)            { r.record("Error", name, v) }
+func (r *recordingVisitor) Default(name string, v any)            { r.record("Default", name, v) }
+
+func TestEventWalkDispatchesByVariant(t *testing.T) {
+	sig := NewSignal("test.walk", "Test walk signal")
+	now := time.Now()
+	testErr := errors.New("boom")
+
+	type custom struct{ ID string }
+
+	event := newEvent(context.Background(), sig, SeverityInfo, now,
+		NewStringKey("string").Field("s"),
+		NewIntKey("int").Field(1),
+		NewInt32Key("int32").Field(2),
+		NewInt64Key("int64").Field(3),
+		NewUintKey("uint").Field(4),
+		NewUint32Key("uint32").Field(5),
+		NewUint64Key("uint64").Field(6),
+		NewFloat32Key("float32").Field(7.5),
+		NewFloat64Key("float64").Field(8.5),
+		NewBoolKey("bool").Field(true),
+		NewTimeKey("time").Field(now),
+		NewDurationKey("duration").Field(time.Second),
+		NewBytesKey("bytes").Field([]byte("b")),
+		NewErrorKey("error").Field(testErr),
+		NewKey[custom]("custom", "test.custom").Field(custom{ID: "c"}),
+	)
+
+	v := newRecordingVisitor()
+	event.Walk(v)
+
+	expected := map[string]string{
+		"string":   "String",
+		"int":      "Int",
+		"int32":    "Int32",
+		"int64":    "Int64",
+		"uint":     "Uint",
+		"uint32":   "Uint32",
+		"uint64":   "Uint64",
+		"float32":  "Float32",
+		"float64":  "Float64",
+		"bool":     "Bool",
+		"time":     "Time",
+		"duration": "Duration",
+		"bytes":    "Bytes",
+		"error":    "Error",
+		"custom":   "Default",
+	}
+
+	if len(v.calls) != len(expected) {
+		t.Fatalf("expected %d visits, got %d", len(expected), len(v.calls))
+	}
+	for name, method := range expected {
+		if v.calls[name] != method {
+			t.Errorf("field %q: expected %s, got %s", name, method, v.calls[name])
+		}
+	}
+
+	if v.vals["custom"] != (custom{ID: "c"}) {
+		t.Errorf("expected custom value passed to Default, got %v", v.vals["custom"])
+	}
+	if v.vals["error"] != testErr {
+		t.Errorf("expected error value %v, got %v", testErr, v.vals["error"])
+	}
+}
+
+func TestEventWalkMismatchedVariantFallsBackToDefault(t *testing.T) {
+	sig := NewSignal("test.walk.mismatch", "Test walk mismatch signal")
+
+	// A key claiming the string variant but carrying an int.
+	bogus := NewKey[int]("bogus", VariantString)
+	event := newEvent(context.Background(), sig, SeverityInfo, time.Now(), bogus.Field(42))
+
+	v := newRecordingVisitor()
+	event.Walk(v)
+
+	if v.calls["bogus"] != "Default" {
+		t.Errorf("expected Default for mismatched variant, got %s", v.calls["bogus"])
+	}
+}
+
+func TestVisitField(t *testing.T) {
+	v := newRecordingVisitor()
+	VisitField(v, NewDurationKey("elapsed").Field(time.Second))
+	VisitField(v, nil)
+
+	if v.calls["elapsed"] != "Duration" || v.vals["elapsed"] != time.Second {
+		t.Errorf("expected Duration dispatch, got %q %v", v.calls["elapsed"], v.vals["elapsed"])
+	}
+	if len(v.calls) != 1 {
+		t.Errorf("expected nil field skipped, got %v", v.calls)
+	}
+}
@@ -2,13 +2,17 @@ package capitan
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Emit dispatches an event with Info severity (default).
 // Queues the event for asynchronous processing by the signal's worker goroutine.
 // Creates a worker goroutine lazily on first emission to this signal.
-// Silently drops events if no listeners are registered for the signal.
+// Silently drops events if no listeners are registered for the signal,
+// returning before the event is constructed.
 // If the context is canceled before the event can be queued, the event is dropped.
 func (c *Capitan) Emit(ctx context.Context, signal Signal, fields ...Field) {
 	c.emitWithSeverity(ctx, signal, SeverityInfo, fields...)
@@ -34,161 +38,787 @@ func (c *Capitan) Error(ctx context.Context, signal Signal, fields ...Field) {
 	c.emitWithSeverity(ctx, signal, SeverityError, fields...)
 }
 
+// EmitSeverity dispatches an event with an arbitrary severity.
+// Severity is a plain string, so custom levels such as "TRACE" or "FATAL" are
+// delivered to listeners untouched. Rank them with WithSeverityOrder to have
+// WithMinSeverity filter them.
+func (c *Capitan) EmitSeverity(ctx context.Context, signal Signal, severity Severity, fields ...Field) {
+	c.emitWithSeverity(ctx, signal, severity, fields...)
+}
+
+// EmitChecked dispatches an Info-severity event like Emit, but returns the
+// error if the event is rejected before queueing: by a pre-emit hook, a field
+// limit, or the emit depth limit. Rejections are still counted as drops and
+// reported to the error handler. Queue drops and missing listeners return nil.
+func (c *Capitan) EmitChecked(ctx context.Context, signal Signal, fields ...Field) error {
+	return c.emit(ctx, nil, signal, SeverityInfo, fields...)
+}
+
 // emitWithSeverity dispatches an event with the given severity level.
 // Internal function used by public emit methods.
 func (c *Capitan) emitWithSeverity(ctx context.Context, signal Signal, severity Severity, fields ...Field) {
+	_ = c.emit(ctx, nil, signal, severity, fields...) //nolint:errcheck // Rejections are reported to the error handler
+}
+
+// emit runs pre-emit hooks and emit-time checks, then dispatches the event.
+// Returns the error that caused the event to be rejected, if any; events
+// dropped for other reasons (shutdown, no listeners, queue drops) return nil.
+// cause is set only by EmitCaused.
+func (c *Capitan) emit(ctx context.Context, cause *eventCause, signal Signal, severity Severity, fields ...Field) error {
+	ctx, fields, ok, err := c.admit(ctx, signal, severity, fields)
+	if !ok {
+		return err
+	}
+
 	// Capture timestamp immediately to preserve chronological ordering
-	timestamp := time.Now()
+	timestamp := c.clock()
 
-	// Track emit count and field schema
-	c.mu.Lock()
-	c.emitCounts[signal]++
-	// Capture field schema on first emit
-	if _, exists := c.fieldSchemas[signal]; !exists && len(fields) > 0 {
-		keys := make([]Key, len(fields))
-		for i, field := range fields {
-			keys[i] = field.Key()
+	// Capture emit site before any further work, only when enabled
+	var callerFile string
+	var callerLine int
+	if c.callerInfo {
+		callerFile, callerLine = callerFrame()
+	}
+
+	c.dispatch(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
+	for _, target := range c.forwardTargets(signal) {
+		c.dispatch(ctx, target, severity, timestamp, callerFile, callerLine, cause, fields)
+	}
+	return nil
+}
+
+// admit applies the emit-time filters and checks to an event about to be
+// dispatched, returning the context and fields to dispatch it with.
+// ok is false if the event must not be dispatched; err is set only when it
+// was rejected by a pre-emit hook, field limit, or the emit depth limit.
+func (c *Capitan) admit(ctx context.Context, signal Signal, severity Severity, fields []Field) (context.Context, []Field, bool, error) {
+	// Drop events below the configured minimum severity
+	if c.minSeverity != "" && !c.severityAtLeast(severity, c.minSeverity) {
+		return ctx, fields, false, nil
+	}
+
+	// Events emitted after Shutdown are dropped visibly rather than vanishing
+	if c.closed.Load() {
+		c.reportDrop(signal, DropReasonShutdown)
+		return ctx, fields, false, nil
+	}
+
+	// Sampled signals drop most events before any further work
+	if c.sampledOut(signal) {
+		return ctx, fields, false, nil
+	}
+
+	// Interceptors see every remaining emission and may veto it
+	if len(c.interceptors) > 0 && c.intercepted(ctx, signal, severity, fields) {
+		return ctx, fields, false, nil
+	}
+
+	// Correlation IDs ride on the context, so enrichers and listeners see them
+	if c.correlate {
+		var id string
+		ctx, id = correlationID(ctx)
+		fields = withCorrelationField(fields, id)
+	}
+
+	// Enrichers add context-derived fields beneath the emitted ones
+	fields = c.enrich(ctx, fields)
+
+	// Pre-emit hooks validate or rewrite fields on the emitting goroutine
+	for _, hook := range c.preEmit[signal] {
+		var err error
+		if fields, err = hook(ctx, fields); err != nil {
+			c.reject(signal, severity, fields, DropReasonRejected, err)
+			return ctx, fields, false, err
 		}
-		c.fieldSchemas[signal] = keys
 	}
-	c.mu.Unlock()
+
+	// Reject events exceeding configured field limits
+	if c.limitsEnabled() {
+		if err := c.checkLimits(fields); err != nil {
+			c.reject(signal, severity, fields, DropReasonLimit, err)
+			return ctx, fields, false, err
+		}
+	}
+
+	// Reject events with duplicate field names when configured to
+	if c.dupPolicy == DupError {
+		if err := checkDuplicates(fields); err != nil {
+			c.reject(signal, severity, fields, DropReasonDuplicate, err)
+			return ctx, fields, false, err
+		}
+	}
+
+	// Cut emit loops: the chain rides on the context listeners receive
+	if c.maxEmitDepth > 0 {
+		var err error
+		if ctx, err = c.trackDepth(ctx, signal); err != nil {
+			c.reject(signal, severity, fields, DropReasonLoop, err)
+			return ctx, fields, false, err
+		}
+	}
+	return ctx, fields, true, nil
+}
+
+// dispatch delivers one event for signal, either inline in sync mode or via
+// the signal's worker.
+func (c *Capitan) dispatch(ctx context.Context, signal Signal, severity Severity, timestamp time.Time, callerFile string, callerLine int, cause *eventCause, fields []Field) {
+	// Track emit count and field schema
+	c.trackEmit(signal, severity, 1, fields)
+
+	// Serial observers take their copy in emit order, whether or not the signal has listeners
+	if c.hasSerials.Load() {
+		c.feedSerial(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
+	}
 
 	// Sync mode: process event directly without workers
 	if c.syncMode {
-		c.mu.RLock()
-		listeners := c.registry[signal]
-		c.mu.RUnlock()
-
-		// If no listeners, attach observers
-		if len(listeners) == 0 {
-			c.mu.Lock()
-			_, registryExists := c.registry[signal]
-			if !registryExists {
-				c.registry[signal] = nil
-				c.attachObservers(signal)
-			}
-			c.mu.Unlock()
+		// Drop event before constructing it if no listeners exist
+		if !c.ensureRegistered(signal) {
+			c.routeFallback(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
+			return
+		}
+		if c.unheard(signal) {
+			c.routeFallback(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
 		}
 
 		// Create and process event synchronously
-		event := newEvent(ctx, signal, severity, timestamp, fields...)
+		event := c.newEvent(c.eventContext(ctx), signal, severity, timestamp, fields...)
+		event.callerFile, event.callerLine = callerFile, callerLine
+		event.cause = cause
 		c.processEvent(signal, event)
 		return
 	}
 
+	// Drop event if no listeners exist for the signal
+	if !c.ensureWorker(signal) {
+		c.routeFallback(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
+		return
+	}
+	if c.unheard(signal) {
+		c.routeFallback(ctx, signal, severity, timestamp, callerFile, callerLine, cause, fields)
+	}
+
+	// Create event from pool
+	event := c.newEvent(c.eventContext(ctx), signal, severity, timestamp, fields...)
+	event.callerFile, event.callerLine = callerFile, callerLine
+	event.cause = cause
+
+	// Capture worker reference atomically to avoid TOCTOU race
+	worker, workerExists := c.liveWorker(signal)
+	if !workerExists {
+		// Worker closed between initial check and now (no listeners)
+		c.pool.Put(event)
+		return
+	}
+
+	c.enqueue(ctx, worker, event)
+}
+
+// EmitBatch dispatches one Info-severity event per field set.
+// The signal's worker is resolved once and all events are sent in a tight loop,
+// avoiding the per-event lock and lookup of calling Emit repeatedly.
+// Per-event semantics match Emit: each field set passes the same emit-time
+// filters, hooks, and limits, and if the context is canceled between sends,
+// the remaining field sets are dropped. With WithCorrelationID, the whole
+// batch shares one correlation ID.
+func (c *Capitan) EmitBatch(ctx context.Context, signal Signal, fieldSets [][]Field) {
+	if c.correlate {
+		ctx, _ = correlationID(ctx)
+	}
+
+	batch := make([]admittedSet, 0, len(fieldSets))
+	for _, fields := range fieldSets {
+		if setCtx, fields, ok, _ := c.admit(ctx, signal, SeverityInfo, fields); ok {
+			batch = append(batch, admittedSet{ctx: setCtx, fields: fields})
+		}
+	}
+	if len(batch) == 0 {
+		return
+	}
+
+	var callerFile string
+	var callerLine int
+	if c.callerInfo {
+		callerFile, callerLine = callerFrame()
+	}
+
+	c.dispatchBatch(signal, callerFile, callerLine, batch)
+	for _, target := range c.forwardTargets(signal) {
+		c.dispatchBatch(target, callerFile, callerLine, batch)
+	}
+}
+
+// admittedSet is a batch field set that passed admit, with the context to
+// dispatch it with.
+type admittedSet struct {
+	ctx    context.Context
+	fields []Field
+}
+
+// dispatchBatch delivers one event per admitted field set for signal,
+// resolving the signal's worker once.
+func (c *Capitan) dispatchBatch(signal Signal, callerFile string, callerLine int, batch []admittedSet) {
+	c.trackEmit(signal, SeverityInfo, len(batch), batch[0].fields)
+
+	if c.hasSerials.Load() {
+		for _, set := range batch {
+			c.feedSerial(set.ctx, signal, SeverityInfo, c.clock(), callerFile, callerLine, nil, set.fields)
+		}
+	}
+
+	if c.syncMode {
+		if !c.ensureRegistered(signal) {
+			c.routeFallbackBatch(signal, callerFile, callerLine, batch)
+			return
+		}
+		if c.unheard(signal) {
+			c.routeFallbackBatch(signal, callerFile, callerLine, batch)
+		}
+		for _, set := range batch {
+			if set.ctx.Err() != nil {
+				return
+			}
+			event := c.newEvent(c.eventContext(set.ctx), signal, SeverityInfo, c.clock(), set.fields...)
+			event.callerFile, event.callerLine = callerFile, callerLine
+			c.processEvent(signal, event)
+		}
+		return
+	}
+
+	if !c.ensureWorker(signal) {
+		c.routeFallbackBatch(signal, callerFile, callerLine, batch)
+		return
+	}
+	if c.unheard(signal) {
+		c.routeFallbackBatch(signal, callerFile, callerLine, batch)
+	}
+	worker, workerExists := c.liveWorker(signal)
+	if !workerExists {
+		return
+	}
+
+	for _, set := range batch {
+		if set.ctx.Err() != nil {
+			return
+		}
+		event := c.newEvent(c.eventContext(set.ctx), signal, SeverityInfo, c.clock(), set.fields...)
+		event.callerFile, event.callerLine = callerFile, callerLine
+		if !c.enqueue(set.ctx, worker, event) {
+			return
+		}
+	}
+}
+
+// eventContext returns the context events carry to listeners.
+// With WithDetachedContext, it keeps ctx's values but drops its cancellation.
+func (c *Capitan) eventContext(ctx context.Context) context.Context {
+	if c.detachContext {
+		return context.WithoutCancel(ctx)
+	}
+	return ctx
+}
+
+// trackEmit records n emissions for a signal and captures its field schema on first emit.
+func (c *Capitan) trackEmit(signal Signal, severity Severity, n int, fields []Field) {
+	for i := 0; i < n; i++ {
+		c.recordEmitted(signal, severity)
+	}
+
+	c.mu.Lock()
+	// Closed instances have released their schemas
+	if c.fieldSchemas == nil {
+		c.mu.Unlock()
+		return
+	}
+	// Capture field schema on first emit, including default fields
+	defaults := c.defaultFields.Load()
+	if _, exists := c.fieldSchemas[signal]; !exists && (len(fields) > 0 || defaults != nil) {
+		var keys KeySet
+		for _, field := range fields {
+			if field != nil {
+				keys.Add(field.Key())
+			}
+		}
+		if defaults != nil {
+			for _, field := range *defaults {
+				keys.Add(field.Key())
+			}
+		}
+		c.fieldSchemas[signal] = keys.Slice()
+	}
+	c.mu.Unlock()
+}
+
+// ensureRegistered attaches active observers to a signal seen for the first time.
+// Used by sync mode, which has no worker creation path to do it.
+// Returns false if the signal has no listeners and retains no history.
+func (c *Capitan) ensureRegistered(signal Signal) bool {
+	c.mu.RLock()
+	listeners, registryExists := c.registry[signal]
+	c.mu.RUnlock()
+
+	if len(listeners) > 0 {
+		return true
+	}
+	if registryExists {
+		// Known signal, observers already attached, still no listeners
+		return c.history[signal] != nil
+	}
+
+	// New signal: attach observers
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Closed instances have released their registry
+	if c.registry == nil {
+		return false
+	}
+	if _, registryExists = c.registry[signal]; !registryExists {
+		c.registry[signal] = nil
+		c.attachObservers(signal)
+	}
+	return len(c.registry[signal]) > 0 || c.history[signal] != nil
+}
+
+// ensureWorker creates the signal's worker goroutine if it doesn't exist yet.
+// Returns false if the signal has no listeners and retains no history, in
+// which case no worker is created.
+func (c *Capitan) ensureWorker(signal Signal) bool {
 	// Fast path: check if worker already exists (read lock)
 	c.mu.RLock()
 	_, exists := c.workers[signal]
+	listeners, registryExists := c.registry[signal]
 	c.mu.RUnlock()
 
-	if !exists {
-		// Slow path: create worker (write lock)
-		c.mu.Lock()
+	if exists {
+		return true
+	}
+	if registryExists && len(listeners) == 0 && c.history[signal] == nil {
+		// Known signal with observers already attached and still no listeners
+		return false
+	}
 
-		// Double-check: another goroutine may have created it
-		_, exists = c.workers[signal]
-		if !exists {
-			// Check if listeners exist before creating worker
-			if len(c.registry[signal]) == 0 {
-				// Check if this is a new signal for observers
-				_, registryExists := c.registry[signal]
-				if !registryExists {
-					// Initialize registry entry for this signal
-					c.registry[signal] = nil
-
-					// Attach to all active observers
-					c.attachObservers(signal)
-				}
+	// Slow path: create worker (write lock)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Closed instances have released their registry
+	if c.registry == nil {
+		return false
+	}
 
-				// If still no listeners after observer attachment, drop event
-				if len(c.registry[signal]) == 0 {
-					c.mu.Unlock()
-					return
-				}
-			}
+	// Double-check: another goroutine may have created it
+	if _, exists = c.workers[signal]; exists {
+		return true
+	}
 
-			// Create worker only if listeners exist
-			newWorker := &workerState{
-				events: make(chan *Event, c.bufferSize),
-				done:   make(chan struct{}),
-			}
-			c.workers[signal] = newWorker
-			c.wg.Add(1)
-			go c.processEvents(signal, newWorker)
+	// Check if listeners exist before creating worker
+	if len(c.registry[signal]) == 0 {
+		// Check if this is a new signal for observers
+		_, registryExists := c.registry[signal]
+		if !registryExists {
+			// Initialize registry entry for this signal
+			c.registry[signal] = nil
+
+			// Attach to all active observers
+			c.attachObservers(signal)
 		}
 
-		c.mu.Unlock()
+		// If still no listeners after observer attachment, drop event
+		// unless it is kept for replay
+		if len(c.registry[signal]) == 0 && c.history[signal] == nil {
+			return false
+		}
 	}
 
-	// Create event from pool
-	event := newEvent(ctx, signal, severity, timestamp, fields...)
+	// Create worker only if listeners exist or history is retained
+	newWorker := &workerState{
+		events: make(chan *Event, c.bufferSize),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	if c.trackQueueDepth {
+		newWorker.depths = &depthHistogram{}
+	}
+	c.workers[signal] = newWorker
+	c.wg.Add(1)
+	go c.processEvents(signal, newWorker)
+	return true
+}
 
-	// Capture worker reference atomically to avoid TOCTOU race
+// currentWorker returns the signal's worker under the read lock.
+func (c *Capitan) currentWorker(signal Signal) (*workerState, bool) {
 	c.mu.RLock()
-	worker, workerExists := c.workers[signal]
+	worker, exists := c.workers[signal]
 	c.mu.RUnlock()
+	return worker, exists
+}
 
-	if !workerExists {
-		// Worker closed between initial check and now (no listeners)
-		eventPool.Put(event)
-		return
+// liveWorker returns the signal's worker like currentWorker, recreating it if
+// an idle worker exited since ensureWorker last checked and listeners remain.
+func (c *Capitan) liveWorker(signal Signal) (*workerState, bool) {
+	worker, exists := c.currentWorker(signal)
+	if !exists && c.idleTimeout > 0 && c.ensureWorker(signal) {
+		worker, exists = c.currentWorker(signal)
+	}
+	return worker, exists
+}
+
+// retireIdle removes an idle worker from the instance, as unregister does for
+// a signal's last listener, so the next emit creates a new one. Returns false,
+// keeping the worker, if it has been replaced or has events queued.
+func (c *Capitan) retireIdle(signal Signal, state *workerState) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.workers[signal] != state || len(state.events) > 0 {
+		return false
+	}
+	close(state.done)
+	delete(c.workers, signal)
+	return true
+}
+
+// enqueue sends an event to a worker's queue, dropping it if it can't be queued.
+// Returns false if the event was dropped.
+func (c *Capitan) enqueue(ctx context.Context, worker *workerState, event *Event) bool {
+	// The emit timeout timer is only created once a non-blocking attempt fails
+	var timer ClockTimer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	deadline := func() <-chan time.Time {
+		if c.emitTimeout <= 0 {
+			return nil // nil channel never fires: wait indefinitely
+		}
+		if timer == nil {
+			timer = c.timers.NewTimer(c.emitTimeout)
+		}
+		return timer.C()
+	}
+
+	event.enqueuedAt = c.clock()
+
+	// Reserve global in-flight capacity before queueing
+	if reason, ok := c.acquireInFlight(ctx, worker, deadline); !ok {
+		c.dropEvent(event, reason)
+		return false
+	}
+
+	// Fast path: queue has room
+	select {
+	case worker.events <- event:
+		worker.enqueued.Add(1)
+		worker.observeDepth()
+		return true
+	default:
 	}
 
+	// Queue is at capacity; remember since when for Health
+	worker.fullSince.CompareAndSwap(0, c.clock().UnixNano())
+
 	// Send to events channel (never closed, so no panic risk)
+	var reason DropReason
 	select {
 	case worker.events <- event:
 		// Event queued successfully
+		worker.enqueued.Add(1)
+		worker.observeDepth()
+		return true
 	case <-ctx.Done():
 		// Context canceled while waiting to queue
-		eventPool.Put(event)
+		reason = DropReasonCanceled
 	case <-worker.done:
 		// Worker shutting down, drop event
-		eventPool.Put(event)
+		reason = DropReasonShutdown
 	case <-c.shutdown:
 		// Global shutdown fired while waiting to send
-		eventPool.Put(event)
+		reason = DropReasonShutdown
+	case <-deadline():
+		// Emit timeout elapsed while waiting to send
+		reason = DropReasonTimeout
+	}
+	c.releaseInFlight()
+	c.dropEvent(event, reason)
+	return false
+}
+
+// acquireInFlight reserves a slot for a queued event.
+// Blocks while the WithMaxInFlight cap is reached; returns false with the drop
+// reason if the context, worker, instance, or emit timeout finishes first.
+func (c *Capitan) acquireInFlight(ctx context.Context, worker *workerState, deadline func() <-chan time.Time) (DropReason, bool) {
+	if c.inFlightCap != nil {
+		select {
+		case c.inFlightCap <- struct{}{}:
+		default:
+			select {
+			case c.inFlightCap <- struct{}{}:
+			case <-ctx.Done():
+				return DropReasonCanceled, false
+			case <-worker.done:
+				return DropReasonShutdown, false
+			case <-c.shutdown:
+				return DropReasonShutdown, false
+			case <-deadline():
+				return DropReasonTimeout, false
+			}
+		}
+	}
+	c.inFlight.Add(1)
+	return "", true
+}
+
+// dropEvent reports a discarded event and returns it to the pool.
+func (c *Capitan) dropEvent(event *Event, reason DropReason) {
+	c.reportDrop(event.signal, reason)
+	c.deadLetter(event, nil, reason, nil)
+	c.pool.Put(event)
+}
+
+// reportDrop counts a dropped event and notifies the drop handler.
+func (c *Capitan) reportDrop(signal Signal, reason DropReason) {
+	c.recordDropped(signal, reason)
+	if c.dropHandler != nil {
+		c.dropHandler(signal, reason)
 	}
 }
 
+// releaseInFlight frees a slot reserved by acquireInFlight.
+func (c *Capitan) releaseInFlight() {
+	c.inFlight.Add(-1)
+	if c.inFlightCap != nil {
+		<-c.inFlightCap
+	}
+}
+
+// processQueued processes an event taken from a worker queue and releases its in-flight slot.
+func (c *Capitan) processQueued(signal Signal, event *Event) {
+	c.processEvent(signal, event)
+	c.releaseInFlight()
+}
+
 // processEvent invokes all listeners for a signal with the given event.
 // Handles panic recovery and returns event to pool.
-// Skips processing if the event's context has been canceled.
+// Skips processing if the event's context has been canceled, unless
+// configured with WithProcessCanceledEvents.
 func (c *Capitan) processEvent(signal Signal, event *Event) {
 	// Check if context was canceled while event was queued
-	if event.ctx.Err() != nil {
+	if event.ctx.Err() != nil && !c.processCanceled {
 		// Skip canceled events
-		eventPool.Put(event)
+		c.mu.Lock()
+		c.canceledCounts[signal]++
+		c.mu.Unlock()
+		if c.canceledHandler != nil {
+			c.canceledHandler(signal, event.Fields())
+		}
+		c.dropEvent(event, DropReasonCanceled)
 		return
 	}
+	if event.results != nil {
+		event.results.processed = true
+	}
 
 	// Copy listener slice while holding lock to prevent data race
 	c.mu.RLock()
 	listeners := make([]*Listener, len(c.registry[signal]))
 	copy(listeners, c.registry[signal])
+	if history := c.history[signal]; history != nil && event.target == nil {
+		history.record(c.pool, event)
+	}
 	c.mu.RUnlock()
 
-	// Invoke all listeners with panic recovery
+	// Signals configured with WithConcurrentListeners run listeners in parallel
+	var sem chan struct{}
+	var running sync.WaitGroup
+	if limit := c.listenerConcurrency[signal]; limit > 1 && len(listeners) > 1 {
+		sem = make(chan struct{}, limit)
+	}
+
+	// Invoke all listeners with panic recovery, noting whether any failed
+	start := c.clock()
+	if !event.enqueuedAt.IsZero() {
+		event.startedAt = start
+	}
+	var failed atomic.Bool
 	for _, listener := range listeners {
-		func() {
-			defer func() {
-				if r := recover(); r != nil && c.panicHandler != nil {
-					c.panicHandler(signal, r)
+		// Retried events are delivered only to the listener that failed
+		if event.target != nil && event.target != listener {
+			continue
+		}
+		// Optionally stop once the context is canceled mid-delivery
+		if c.cancelBetween && event.ctx.Err() != nil {
+			failed.Store(true)
+			break
+		}
+		if listener.queue != nil {
+			c.handOff(listener, event)
+			continue
+		}
+		if listener.ready != nil {
+			<-listener.ready
+		}
+		if sem != nil {
+			sem <- struct{}{}
+			running.Add(1)
+			go func(listener *Listener) {
+				defer running.Done()
+				defer func() { <-sem }()
+				if !c.invokeListener(signal, listener, event) {
+					failed.Store(true)
 				}
-			}()
-			listener.callback(event.ctx, event)
-		}()
+			}(listener)
+			continue
+		}
+		if !c.invokeListener(signal, listener, event) {
+			failed.Store(true)
+		}
+	}
+	// The event returns to the pool below, so every listener must be done with it
+	running.Wait()
+	c.recordProcessed(signal, c.clock().Sub(start))
+	if !failed.Load() {
+		c.metrics.eventSucceeded(signal)
 	}
 
 	// Return event to pool
-	eventPool.Put(event)
+	c.pool.Put(event)
+}
+
+// invokeListener runs a single listener with panic recovery,
+// applying the error policy if an error-returning listener fails.
+// Reports whether the listener returned without an error or panic.
+func (c *Capitan) invokeListener(signal Signal, listener *Listener, event *Event) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.reportPanic(signal, listener, event, r)
+			ok = false
+		}
+	}()
+	var start time.Time
+	if c.slowHandler != nil {
+		start = c.clock()
+	}
+	err := listener.invoke(event.ctx, event)
+	if c.slowHandler != nil {
+		if took := c.clock().Sub(start); took > c.slowThreshold {
+			c.slowHandler(signal, listener.label(), took)
+		}
+	}
+	if err != nil {
+		c.handleListenerError(signal, listener, event, err)
+		return false
+	}
+	return true
+}
+
+// reportPanic records a recovered listener panic and passes it to the panic handler.
+// Returns the panic as an error.
+func (c *Capitan) reportPanic(signal Signal, listener *Listener, event *Event, recovered any) error {
+	err := fmt.Errorf("panic: %v", recovered)
+	c.recordPanic(signal)
+	c.deadLetter(event, listener, DropReasonPanic, err)
+	c.mu.RLock()
+	handler := c.panicHandler
+	c.mu.RUnlock()
+	if handler != nil {
+		handler(signal, recovered)
+	}
+	return err
+}
+
+// handleListenerError applies the listener's RetryPolicy, if any, or the
+// configured ErrorPolicy to a failed listener.
+func (c *Capitan) handleListenerError(signal Signal, listener *Listener, event *Event, err error) {
+	if p := listener.retryPolicy; p != nil {
+		if event.Attempt() < p.MaxAttempts {
+			c.retry(signal, listener, event, p.Backoff(event.Attempt()))
+			return
+		}
+	} else {
+		switch c.errorPolicy {
+		case ErrorPolicyIgnore:
+			return
+		case ErrorPolicyRetry:
+			if event.attempt < c.maxRetries {
+				var delay time.Duration
+				if c.retryBackoff != nil {
+					delay = c.retryBackoff(event.Attempt())
+				}
+				c.retry(signal, listener, event, delay)
+				return
+			}
+		}
+	}
+	c.deadLetter(event, listener, DropReasonListenerError, err)
+	if c.errorHandler != nil {
+		c.errorHandler(signal, err)
+	}
+}
+
+// retry re-delivers a clone of the event to the failed listener only, after delay.
+// In async mode the clone is re-enqueued from a separate goroutine, so the
+// worker never blocks on the delay or its own queue. The wait is abandoned if
+// the event's context is canceled (unless canceled events are processed) or
+// the instance shuts down.
+func (c *Capitan) retry(signal Signal, listener *Listener, event *Event, delay time.Duration) {
+	retried := event.clone(c.pool)
+	retried.attempt++
+	retried.target = listener
+
+	canceled := retried.ctx.Done()
+	if c.processCanceled {
+		canceled = nil
+	}
+
+	if c.syncMode {
+		if delay > 0 {
+			timer := c.timers.NewTimer(delay)
+			select {
+			case <-timer.C():
+			case <-canceled:
+				timer.Stop()
+			}
+		}
+		// processEvent drops the event if its context was canceled meanwhile
+		c.processEvent(signal, retried)
+		return
+	}
+
+	// The calling worker holds a WaitGroup slot, so Add cannot race Shutdown's Wait
+	c.wg.Add(1)
+	c.pendingRetries.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.pendingRetries.Add(-1)
+		if delay > 0 {
+			timer := c.timers.NewTimer(delay)
+			select {
+			case <-timer.C():
+			case <-canceled:
+				timer.Stop()
+				c.dropEvent(retried, DropReasonCanceled)
+				return
+			case <-c.shutdown:
+				timer.Stop()
+				c.dropEvent(retried, DropReasonShutdown)
+				return
+			}
+		}
+		worker, exists := c.liveWorker(signal)
+		if !exists {
+			c.dropEvent(retried, DropReasonShutdown)
+			return
+		}
+		c.enqueue(retried.ctx, worker, retried)
+	}()
 }
 
 // drainEvents processes all remaining events in the queue then returns.
-func (c *Capitan) drainEvents(signal Signal, events chan *Event) {
+func (c *Capitan) drainEvents(signal Signal, state *workerState) {
 	for {
 		select {
-		case event := <-events:
-			c.processEvent(signal, event)
+		case event := <-state.events:
+			c.processQueued(signal, event)
+			state.processed.Add(1)
 		default:
 			return
 		}
@@ -200,35 +830,169 @@ func (c *Capitan) drainEvents(signal Signal, events chan *Event) {
 func (c *Capitan) processEvents(signal Signal, state *workerState) {
 	defer c.wg.Done()
 	defer func() {
-		// Clean up worker state when exiting
+		// Clean up worker state when exiting, unless a new worker took over
 		c.mu.Lock()
-		delete(c.workers, signal)
+		if c.workers[signal] == state {
+			delete(c.workers, signal)
+		}
 		c.mu.Unlock()
+		close(state.exited)
 	}()
 
+	// With WithWorkerIdleTimeout, the worker exits once no event arrives for the timeout
+	var idle <-chan time.Time
+	var idleTimer ClockTimer
+	if c.idleTimeout > 0 {
+		idleTimer = c.timers.NewTimer(c.idleTimeout)
+		defer idleTimer.Stop()
+		idle = idleTimer.C()
+	}
+
 	for {
 		select {
 		case event := <-state.events:
-			c.processEvent(signal, event)
+			if state.depths != nil {
+				state.depths.observe(len(state.events))
+			}
+			state.fullSince.Store(0)
+			state.current.Store(event.timestamp.UnixNano())
+			c.processQueued(signal, event)
+			state.current.Store(0)
+			state.processed.Add(1)
+			if idleTimer != nil {
+				idleTimer.Reset(c.idleTimeout)
+			}
+
+		case <-idle:
+			if c.retireIdle(signal, state) {
+				// Events sent by emitters that resolved the worker just before it retired
+				c.drainEvents(signal, state)
+				return
+			}
+			idleTimer.Reset(c.idleTimeout)
 
 		case <-state.done:
 			// Per-worker shutdown: drain remaining events then exit
-			c.drainEvents(signal, state.events)
+			c.drainEvents(signal, state)
 			return
 
 		case <-c.shutdown:
 			// Global shutdown: drain remaining events then exit
-			c.drainEvents(signal, state.events)
+			c.drainEvents(signal, state)
 			return
 		}
 	}
 }
 
+// flushInterval is how often Flush polls for idle workers.
+const flushInterval = time.Millisecond
+
+// Flush blocks until every worker and buffered listener queue is empty, no
+// event is being processed, and no retry is pending, or until ctx is done.
+// Unlike Shutdown, workers keep running, so Flush can be called repeatedly,
+// e.g. between test assertions.
+// Events emitted concurrently with Flush may or may not be waited for.
+func (c *Capitan) Flush(ctx context.Context) error {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for !c.idle() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// DrainSignal blocks until every event queued for signal when it was called
+// has been processed by the signal's worker, or until ctx is done, and
+// returns how many of those events were processed. Events emitted after the
+// call are not waited for or counted, so a steady stream of emits cannot keep
+// it blocked. Events handed to HookBuffered listeners are processed on their
+// own goroutines and are not waited for. Returns (0, nil) if the signal has
+// no worker, including in sync mode.
+func (c *Capitan) DrainSignal(ctx context.Context, signal Signal) (processed int, err error) {
+	worker, exists := c.currentWorker(signal)
+	if !exists {
+		return 0, nil
+	}
+	start := worker.processed.Load()
+	target := worker.enqueued.Load()
+	progress := func() int {
+		return int(min(worker.processed.Load(), target) - min(start, target))
+	}
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for worker.processed.Load() < target {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return progress(), ctx.Err()
+		}
+	}
+	return progress(), nil
+}
+
+// idle reports whether no events are queued, processing, or awaiting retry.
+func (c *Capitan) idle() bool {
+	if c.inFlight.Load() != 0 || c.pendingRetries.Load() != 0 || c.bufferedPending.Load() != 0 || c.serialPending.Load() != 0 {
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, worker := range c.workers {
+		if len(worker.events) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsShutdown reports whether Shutdown has been called. Events emitted after
+// Shutdown are dropped and reported to the drop handler with DropReasonShutdown.
+func (c *Capitan) IsShutdown() bool {
+	return c.closed.Load()
+}
+
 // Shutdown gracefully stops all worker goroutines, draining pending events.
-// Safe to call multiple times; subsequent calls are no-ops.
+// Safe to call multiple times; subsequent calls are no-ops. Later emits are
+// dropped with DropReasonShutdown.
 func (c *Capitan) Shutdown() {
 	c.shutdownOnce.Do(func() {
+		c.closed.Store(true)
 		close(c.shutdown)
 	})
 	c.wg.Wait()
+	c.stopBufferedListeners()
+}
+
+// Close shuts the instance down like Shutdown, then releases its listener
+// registry, workers, observers, emit counts, and field schemas so a finished
+// instance doesn't pin them in memory. Stats reports empty per-signal data
+// afterward, and listeners hooked after Close are never registered.
+// Close implements io.Closer; the returned error is always nil.
+func (c *Capitan) Close() error {
+	c.Shutdown()
+
+	c.mu.Lock()
+	for _, listeners := range c.registry {
+		for _, l := range listeners {
+			l.active.Store(false)
+		}
+	}
+	if fallback := c.fallback.Swap(nil); fallback != nil {
+		fallback.active.Store(false)
+	}
+	c.registry = nil
+	c.workers = nil
+	c.observers = nil
+	c.fieldSchemas = nil
+	c.mu.Unlock()
+	c.metrics.releaseSignals()
+	return nil
 }
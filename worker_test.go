@@ -2,7 +2,11 @@ package capitan
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -287,50 +291,6 @@ func TestWorkerCreatedWithObserver(t *testing.T) {
 	}
 }
 
-func TestWorkerMultipleSignalsIsolated(t *testing.T) {
-	c := New()
-	defer c.Shutdown()
-
-	sig1 := NewSignal("test.worker.iso1", "Test worker isolation signal 1")
-	sig2 := NewSignal("test.worker.iso2", "Test worker isolation signal 2")
-	key := NewStringKey("value")
-
-	count1, count2 := 0, 0
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-	wg.Add(3)
-
-	c.Hook(sig1, func(_ context.Context, _ *Event) {
-		mu.Lock()
-		count1++
-		mu.Unlock()
-		wg.Done()
-	})
-
-	c.Hook(sig2, func(_ context.Context, _ *Event) {
-		mu.Lock()
-		count2++
-		mu.Unlock()
-		wg.Done()
-	})
-
-	c.Emit(context.Background(), sig1, key.Field("first"))
-	c.Emit(context.Background(), sig1, key.Field("second"))
-	c.Emit(context.Background(), sig2, key.Field("third"))
-
-	wg.Wait()
-
-	mu.Lock()
-	defer mu.Unlock()
-
-	if count1 != 2 {
-		t.Errorf("sig1: expected 2 events, got %d", count1)
-	}
-	if count2 != 1 {
-		t.Errorf("sig2: expected 1 event, got %d", count2)
-	}
-}
-
 func TestWorkerContextCancellation(t *testing.T) {
 	c := New()
 	defer c.Shutdown()
@@ -353,7 +313,9 @@ func TestWorkerContextCancellation(t *testing.T) {
 
 	c.Emit(ctx, sig, key.Field("test"))
 
-	time.Sleep(50 * time.Millisecond)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
 
 	mu.Lock()
 	count := received
@@ -437,8 +399,9 @@ func TestWorkerSkipsCancelledEvents(t *testing.T) {
 	// Release first event (second should be skipped due to canceled context)
 	close(firstEvent)
 
-	// Give time for processing
-	time.Sleep(50 * time.Millisecond)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
 
 	mu.Lock()
 	count := received
@@ -509,3 +472,581 @@ func TestEmitBlockedOnShutdown(t *testing.T) {
 		t.Fatal("Shutdown did not complete")
 	}
 }
+
+func TestEmitBatch(t *testing.T) {
+	c := New(WithBufferSize(4))
+
+	sig := NewSignal("test.batch", "Test batch signal")
+	key := NewIntKey("value")
+
+	var mu sync.Mutex
+	var received []int
+
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		v, _ := key.From(e)
+		mu.Lock()
+		received = append(received, v)
+		mu.Unlock()
+	})
+
+	const numRecords = 100
+	fieldSets := make([][]Field, numRecords)
+	for i := range fieldSets {
+		fieldSets[i] = []Field{key.Field(i)}
+	}
+
+	c.EmitBatch(context.Background(), sig, fieldSets)
+	c.Shutdown()
+
+	if len(received) != numRecords {
+		t.Fatalf("expected %d events, got %d", numRecords, len(received))
+	}
+	for i, v := range received {
+		if v != i {
+			t.Fatalf("expected events in order, got %d at position %d", v, i)
+		}
+	}
+
+	if count := c.Stats().EmitCounts[sig]; count != numRecords {
+		t.Errorf("expected emit count %d, got %d", numRecords, count)
+	}
+}
+
+func TestEmitBatchStopsOnCancel(t *testing.T) {
+	c := New(WithBufferSize(1))
+
+	sig := NewSignal("test.batch.cancel", "Test batch cancel signal")
+	key := NewIntKey("value")
+
+	block := make(chan struct{})
+	var mu sync.Mutex
+	received := 0
+
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		<-block
+		mu.Lock()
+		received++
+		mu.Unlock()
+	})
+
+	fieldSets := make([][]Field, 10)
+	for i := range fieldSets {
+		fieldSets[i] = []Field{key.Field(i)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// First event is taken by the worker, second fills the buffer, third blocks until timeout
+	c.EmitBatch(ctx, sig, fieldSets)
+
+	close(block)
+	c.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if received >= len(fieldSets) {
+		t.Errorf("expected batch to stop after cancellation, got %d events", received)
+	}
+}
+
+func TestEmitBatchSyncMode(t *testing.T) {
+	c := New(WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.batch.sync", "Test batch sync signal")
+	key := NewStringKey("value")
+
+	var received []string
+	c.Hook(sig, func(_ context.Context, e *Event) {
+		v, _ := key.From(e)
+		received = append(received, v)
+	})
+
+	c.EmitBatch(context.Background(), sig, [][]Field{{key.Field("a")}, {key.Field("b")}})
+
+	if len(received) != 2 || received[0] != "a" || received[1] != "b" {
+		t.Errorf("expected [a b], got %v", received)
+	}
+}
+
+func benchmarkFieldSets(n int) [][]Field {
+	key := NewIntKey("value")
+	fieldSets := make([][]Field, n)
+	for i := range fieldSets {
+		fieldSets[i] = []Field{key.Field(i)}
+	}
+	return fieldSets
+}
+
+func BenchmarkEmitLoop(b *testing.B) {
+	c := New(WithBufferSize(1024))
+	defer c.Shutdown()
+
+	sig := NewSignal("bench.emit.loop", "Benchmark emit loop signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	fieldSets := benchmarkFieldSets(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, fields := range fieldSets {
+			c.Emit(context.Background(), sig, fields...)
+		}
+	}
+}
+
+func BenchmarkEmitBatch(b *testing.B) {
+	c := New(WithBufferSize(1024))
+	defer c.Shutdown()
+
+	sig := NewSignal("bench.emit.batch", "Benchmark emit batch signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+	fieldSets := benchmarkFieldSets(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.EmitBatch(context.Background(), sig, fieldSets)
+	}
+}
+
+func TestCanceledEventHandler(t *testing.T) {
+	var mu sync.Mutex
+	var canceledSignal Signal
+	var canceledFields []Field
+
+	c := New(WithCanceledEventHandler(func(signal Signal, fields []Field) {
+		mu.Lock()
+		canceledSignal = signal
+		canceledFields = fields
+		mu.Unlock()
+	}))
+
+	sig := NewSignal("test.canceled.handler", "Test canceled handler signal")
+	key := NewStringKey("value")
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	first := true
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		if first {
+			first = false
+			close(started)
+			<-block
+		}
+	})
+
+	c.Emit(context.Background(), sig, key.Field("first"))
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Emit(ctx, sig, key.Field("second"))
+	cancel()
+
+	close(block)
+	c.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if canceledSignal != sig {
+		t.Errorf("expected handler for %v, got %v", sig, canceledSignal)
+	}
+	if len(canceledFields) != 1 || canceledFields[0].Value() != "second" {
+		t.Errorf("expected canceled event fields, got %v", canceledFields)
+	}
+	if count := c.Stats().CanceledCounts[sig]; count != 1 {
+		t.Errorf("expected 1 canceled event in stats, got %d", count)
+	}
+}
+
+func TestWithProcessCanceledEvents(t *testing.T) {
+	c := New(WithProcessCanceledEvents(), WithSyncMode())
+	defer c.Shutdown()
+
+	sig := NewSignal("test.canceled.process", "Test process canceled signal")
+
+	var ctxErr error
+	calls := 0
+	c.Hook(sig, func(ctx context.Context, _ *Event) {
+		calls++
+		ctxErr = ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Emit(ctx, sig)
+
+	if calls != 1 {
+		t.Fatalf("expected canceled event to be processed, got %d calls", calls)
+	}
+	if ctxErr == nil {
+		t.Error("expected listener to observe canceled context")
+	}
+	if count := c.Stats().CanceledCounts[sig]; count != 0 {
+		t.Errorf("expected no canceled skips, got %d", count)
+	}
+}
+
+func TestEmitNoListenersZeroAlloc(t *testing.T) {
+	for _, mode := range []struct {
+		name string
+		opts []Option
+	}{
+		{"async", nil},
+		{"sync", []Option{WithSyncMode()}},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			c := New(mode.opts...)
+			defer c.Shutdown()
+
+			sig := NewSignal("test.noalloc", "Test no-alloc signal")
+			ctx := context.Background()
+
+			allocs := testing.AllocsPerRun(100, func() {
+				c.Emit(ctx, sig)
+			})
+			if allocs != 0 {
+				t.Errorf("expected 0 allocations, got %v", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkEmitNoListeners(b *testing.B) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("bench.nolisteners", "Benchmark no listeners signal")
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Emit(ctx, sig)
+	}
+}
+
+func TestFlush(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.flush", "Test flush signal")
+
+	var mu sync.Mutex
+	var received int
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		received++
+		mu.Unlock()
+	})
+
+	// Flush is repeatable and leaves workers running
+	for round := 1; round <= 2; round++ {
+		for i := 0; i < 5; i++ {
+			c.Emit(context.Background(), sig)
+		}
+		if err := c.Flush(context.Background()); err != nil {
+			t.Fatalf("flush failed: %v", err)
+		}
+
+		mu.Lock()
+		got := received
+		mu.Unlock()
+		if got != round*5 {
+			t.Errorf("round %d: expected %d events after flush, got %d", round, round*5, got)
+		}
+	}
+
+	if stats := c.Stats(); stats.ActiveWorkers != 1 {
+		t.Errorf("expected worker to keep running, got %d active", stats.ActiveWorkers)
+	}
+}
+
+func TestFlushContextDone(t *testing.T) {
+	c := New()
+	sig := NewSignal("test.flush.timeout", "Test flush timeout signal")
+
+	release := make(chan struct{})
+	c.Hook(sig, func(_ context.Context, _ *Event) { <-release })
+	c.Emit(context.Background(), sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := c.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	close(release)
+	c.Shutdown()
+}
+
+func TestDrainSignal(t *testing.T) {
+	c := New()
+	defer c.Shutdown()
+
+	sig := NewSignal("test.drain.signal", "Test drain signal")
+	other := NewSignal("test.drain.signal.other", "Test drain other signal")
+	seq := NewIntKey("seq")
+
+	release := make(chan struct{})
+	hold := make(chan struct{})
+	c.Hook(other, func(_ context.Context, _ *Event) { <-hold })
+	c.Hook(sig, func(ctx context.Context, e *Event) {
+		switch v, _ := seq.From(e); v {
+		case 1:
+			<-release
+		case 3:
+			// Emitted after DrainSignal started, so it isn't waited for
+			c.Emit(ctx, sig, seq.Field(4))
+		case 4:
+			<-hold
+		}
+	})
+	c.Emit(context.Background(), other)
+	for i := 1; i <= 3; i++ {
+		c.Emit(context.Background(), sig, seq.Field(i))
+	}
+
+	time.AfterFunc(20*time.Millisecond, func() { close(release) })
+	processed, err := c.DrainSignal(context.Background(), sig)
+	if err != nil {
+		t.Fatalf("drain failed: %v", err)
+	}
+	if processed != 3 {
+		t.Errorf("expected the 3 events queued before the drain, got %d", processed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if processed, err := c.DrainSignal(ctx, sig); !errors.Is(err, context.DeadlineExceeded) || processed != 0 {
+		t.Errorf("expected (0, deadline exceeded) while blocked, got (%d, %v)", processed, err)
+	}
+	close(hold)
+
+	unknown := NewSignal("test.drain.signal.none", "Test drain signal without worker")
+	if processed, err := c.DrainSignal(context.Background(), unknown); processed != 0 || err != nil {
+		t.Errorf("expected no-op for signal without worker, got (%d, %v)", processed, err)
+	}
+}
+
+func TestQueueHighWatermark(t *testing.T) {
+	c := New(WithBufferSize(8))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.queue.watermark", "Test queue watermark signal")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		once.Do(func() { close(started) })
+		<-release
+	})
+
+	c.Emit(context.Background(), sig) // held by the listener
+	<-started
+	for i := 0; i < 5; i++ {
+		c.Emit(context.Background(), sig)
+	}
+
+	stats := c.Stats()
+	if stats.QueueDepths[sig] != 5 || stats.QueueHighWatermarks[sig] != 5 {
+		t.Errorf("expected depth and watermark 5, got %d/%d", stats.QueueDepths[sig], stats.QueueHighWatermarks[sig])
+	}
+
+	close(release)
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	stats = c.Stats()
+	if stats.QueueDepths[sig] != 0 {
+		t.Errorf("expected drained queue, got depth %d", stats.QueueDepths[sig])
+	}
+	if stats.QueueHighWatermarks[sig] != 5 {
+		t.Errorf("expected watermark to stay 5, got %d", stats.QueueHighWatermarks[sig])
+	}
+	if stats.QueueCapacities[sig] != 8 {
+		t.Errorf("expected capacity 8, got %d", stats.QueueCapacities[sig])
+	}
+}
+
+func TestEmitAfterShutdown(t *testing.T) {
+	var mu sync.Mutex
+	var drops []DropReason
+	c := New(WithDropHandler(func(_ Signal, reason DropReason) {
+		mu.Lock()
+		drops = append(drops, reason)
+		mu.Unlock()
+	}))
+
+	sig := NewSignal("test.emit.after.shutdown", "Test emit after shutdown signal")
+	var received int
+	c.Hook(sig, func(_ context.Context, _ *Event) {
+		mu.Lock()
+		received++
+		mu.Unlock()
+	})
+
+	if c.IsShutdown() {
+		t.Fatal("expected IsShutdown false before Shutdown")
+	}
+	c.Shutdown()
+	if !c.IsShutdown() {
+		t.Fatal("expected IsShutdown true after Shutdown")
+	}
+
+	c.Emit(context.Background(), sig)
+	c.EmitBatch(context.Background(), sig, [][]Field{nil, nil})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if received != 0 {
+		t.Errorf("expected no deliveries after shutdown, got %d", received)
+	}
+	if len(drops) != 3 || drops[0] != DropReasonShutdown {
+		t.Errorf("expected 3 shutdown drops, got %v", drops)
+	}
+	if c.Stats().ActiveWorkers != 0 {
+		t.Error("expected no worker to be started after shutdown")
+	}
+}
+
+func TestClose(t *testing.T) {
+	c := New()
+	var closer io.Closer = c
+
+	sig := NewSignal("test.close", "Test close signal")
+	var received sync.WaitGroup
+	received.Add(1)
+	c.Hook(sig, func(_ context.Context, _ *Event) { received.Done() })
+	c.Observe(func(_ context.Context, _ *Event) {})
+	c.Emit(context.Background(), sig, NewStringKey("k").Field("v"))
+	received.Wait()
+
+	if err := closer.Close(); err != nil {
+		t.Fatalf("expected nil error from Close, got %v", err)
+	}
+	if !c.IsShutdown() {
+		t.Error("expected Close to shut the instance down")
+	}
+
+	stats := c.Stats()
+	if len(stats.ListenerCounts) != 0 || len(stats.EmitCounts) != 0 || len(stats.FieldSchemas) != 0 || len(stats.Observers) != 0 {
+		t.Errorf("expected released state after Close, got %+v", stats)
+	}
+
+	// Hooking and closing listeners afterward must not panic
+	c.Hook(sig, func(_ context.Context, _ *Event) {}).Close()
+	if err := c.Close(); err != nil {
+		t.Errorf("expected repeated Close to succeed, got %v", err)
+	}
+}
+
+// TestConcurrentEmitAndClose verifies emits racing Close never touch the
+// released registry, workers, or field schemas.
+func TestConcurrentEmitAndClose(t *testing.T) {
+	key := NewIntKey("n")
+
+	for _, mode := range []struct {
+		name string
+		opts []Option
+	}{
+		{"async", nil},
+		{"sync", []Option{WithSyncMode()}},
+	} {
+		t.Run(mode.name, func(_ *testing.T) {
+			for round := 0; round < 10; round++ {
+				c := New(mode.opts...)
+				started := make(chan struct{}, 4)
+
+				// Each emit uses a new signal, so it takes the registration paths
+				var wg sync.WaitGroup
+				for g := 0; g < 4; g++ {
+					wg.Add(1)
+					go func(g int) {
+						defer wg.Done()
+						started <- struct{}{}
+						for i := 0; !c.IsShutdown() || i%64 != 0; i++ {
+							sig := NewSignal(fmt.Sprintf("test.close.race.%d.%d", g, i), "Test close race signal")
+							c.Emit(context.Background(), sig, key.Field(i))
+						}
+					}(g)
+				}
+				for g := 0; g < 4; g++ {
+					<-started
+				}
+				_ = c.Close()
+				wg.Wait()
+			}
+		})
+	}
+}
+
+// TestWorkerIdleTimeout verifies idle workers exit and are recreated on the next emit.
+func TestWorkerIdleTimeout(t *testing.T) {
+	c := New(WithWorkerIdleTimeout(20 * time.Millisecond))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.worker.idle", "Test worker idle signal")
+	var received atomic.Int32
+	c.Hook(sig, func(_ context.Context, _ *Event) { received.Add(1) })
+
+	waitIdle := func() {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for c.Stats().ActiveWorkers != 0 {
+			if time.Now().After(deadline) {
+				t.Fatal("expected idle worker to exit")
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	for i := 1; i <= 3; i++ {
+		c.Emit(context.Background(), sig)
+		if err := c.Flush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if got := received.Load(); got != int32(i) {
+			t.Fatalf("expected %d events delivered, got %d", i, got)
+		}
+		waitIdle()
+	}
+
+	if got := c.ListenerCount(sig); got != 1 {
+		t.Errorf("expected listener kept when worker retires, got %d", got)
+	}
+}
+
+// TestWorkerIdleTimeoutBusy verifies a worker receiving events doesn't retire.
+func TestWorkerIdleTimeoutBusy(t *testing.T) {
+	c := New(WithWorkerIdleTimeout(50 * time.Millisecond))
+	defer c.Shutdown()
+
+	sig := NewSignal("test.worker.idle.busy", "Test worker idle busy signal")
+	c.Hook(sig, func(_ context.Context, _ *Event) {})
+
+	c.Emit(context.Background(), sig)
+	worker, _ := c.currentWorker(sig)
+	for range 10 {
+		time.Sleep(10 * time.Millisecond)
+		c.Emit(context.Background(), sig)
+	}
+
+	if current, ok := c.currentWorker(sig); !ok || current != worker {
+		t.Error("expected the same worker to keep running while events arrive")
+	}
+}
+
+// TestWorkerIdleTimeoutValidation verifies invalid idle timeouts are reported.
+func TestWorkerIdleTimeoutValidation(t *testing.T) {
+	if _, err := NewValidated(WithWorkerIdleTimeout(-time.Second)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected negative timeout rejected, got %v", err)
+	}
+	if _, err := NewValidated(WithWorkerIdleTimeout(time.Second), WithSyncMode()); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected sync mode conflict reported, got %v", err)
+	}
+}